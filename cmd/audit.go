@@ -0,0 +1,171 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/meloncoffee/unisys/config"
+	"github.com/meloncoffee/unisys/internal/audit"
+	"github.com/meloncoffee/unisys/pkg/util/file"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// auditOperation 감사 로그 관리 명령 구조체
+type auditOperation struct{}
+
+// verify 감사 로그 무결성 검증
+//
+// Parameters:
+//   - cmd: cobra 명령어 정보 구조체
+//   - args: 위치 인자 (사용 안함)
+//
+// Returns:
+//   - error: 정상 종료(nil), 비정상 종료(error)
+func (a *auditOperation) verify(cmd *cobra.Command, args []string) error {
+	// 작업 경로를 현재 프로세스가 위치한 경로로 변경
+	err := file.ChangeWorkPathToModulePath()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	// 설정 파일 로드 (설정 파일이 없으면 기본 경로 사용)
+	config.Conf.LoadConfig(config.ConfFilePath)
+
+	logPath, _ := cmd.Flags().GetString("file")
+	if logPath == "" {
+		logPath = config.Conf.Audit.File
+	}
+	keyPath, _ := cmd.Flags().GetString("key")
+	if keyPath == "" {
+		keyPath = config.Conf.Audit.KeyFile
+	}
+
+	result, err := audit.Verify(logPath, keyPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] audit log verification failed after %d valid entries: %v\n",
+			result.Entries, err)
+		return err
+	}
+
+	fmt.Fprintf(os.Stdout, "[INFO] audit log verified (file:%s, entries:%d, last seq:%d)\n",
+		logPath, result.Entries, result.LastSeq)
+	return nil
+}
+
+// auditedCommand 관리 명령의 실행 결과를 감사 로그에 기록하도록 랩핑
+// 명령 실행 후 기록하므로 기록에 실패해도 명령은 이미 수행된 상태이며, 이 경우 에러를 반환하여 비정상 종료
+//
+// Parameters:
+//   - function: 명령어 함수
+//
+// Returns:
+//   - func(cmd *cobra.Command, args []string) error: 랩핑된 명령어 함수
+func auditedCommand(function func(cmd *cobra.Command, args []string) error) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		start := time.Now()
+		runErr := function(cmd, args)
+
+		// 명령어 함수에서 설정 로드에 실패했을 수 있으므로 다시 로드
+		if err := file.ChangeWorkPathToModulePath(); err != nil {
+			return runErr
+		}
+		config.Conf.LoadConfig(config.ConfFilePath)
+		if !config.Conf.Audit.Enabled {
+			return runErr
+		}
+
+		params := make(map[string]string)
+		cmd.Flags().Visit(func(f *pflag.Flag) {
+			params[f.Name] = f.Value.String()
+		})
+		for i, arg := range args {
+			params["arg"+strconv.Itoa(i)] = arg
+		}
+
+		entry := audit.Entry{
+			Time:       start,
+			Source:     audit.SourceCLI,
+			Identity:   osUserName(),
+			AuthMethod: "os",
+			Action:     strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" "),
+			Result:     audit.ResultSuccess,
+			DurationMs: float64(time.Since(start).Microseconds()) / 1000,
+		}
+		if len(params) > 0 {
+			entry.Params = params
+		}
+		if runErr != nil {
+			entry.Result = audit.ResultFailure
+			entry.Error = runErr.Error()
+		}
+
+		if err := audit.Record(entry); err != nil {
+			fmt.Fprintf(os.Stderr, "[ERROR] %s was executed but failed to write audit log: %v\n", entry.Action, err)
+			if runErr == nil {
+				return fmt.Errorf("failed to write audit log: %v", err)
+			}
+		}
+		return runErr
+	}
+}
+
+// osUserName 명령을 실행한 OS 사용자 이름 (sudo 실행 시 원래 사용자 포함)
+//
+// Returns:
+//   - string: 사용자 이름
+func osUserName() string {
+	name := "uid:" + strconv.Itoa(os.Getuid())
+	if u, err := user.Current(); err == nil {
+		name = u.Username
+	}
+	if sudoUser := os.Getenv("SUDO_USER"); sudoUser != "" {
+		name += " (sudo by " + sudoUser + ")"
+	}
+	return name
+}
+
+var auditOper auditOperation
+
+// auditCmd 감사 로그 관리 명령
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Manage the audit log",
+}
+
+// auditVerifyCmd 감사 로그 검증 명령
+var auditVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify the audit log hash chain and detect tampering or truncation",
+	Args:  cobra.NoArgs,
+	RunE:  WrapArgsCommandFuncForCobra(auditOper.verify),
+}
+
+// init 감사 로그 명령 초기화
+func init() {
+	auditVerifyCmd.Flags().String("file", "", "audit log file (default: audit.file in config)")
+	auditVerifyCmd.Flags().String("key", "", "audit key file (default: audit.keyFile in config)")
+
+	auditCmd.AddCommand(auditVerifyCmd)
+}
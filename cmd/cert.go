@@ -0,0 +1,458 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package cmd
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/meloncoffee/unisys/config"
+	"github.com/meloncoffee/unisys/internal/certs"
+	"github.com/meloncoffee/unisys/pkg/util/file"
+	"github.com/spf13/cobra"
+)
+
+// 인증서 기본 파일 경로 (설정 파일 기본값과 동일)
+const (
+	defaultServerCertFile = "auth/server.crt"
+	defaultServerKeyFile  = "auth/server.key"
+	defaultCACertFile     = "auth/ca.crt"
+	defaultCAKeyFile      = "auth/ca.key"
+)
+
+// certFileNamePattern 발급 인증서 기본 파일 이름에 사용할 수 없는 문자
+var certFileNamePattern = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// certOperation 인증서 관리 명령 구조체
+type certOperation struct{}
+
+// loadConfig 작업 경로 변경 및 설정 파일 로드
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func (c *certOperation) loadConfig() error {
+	// 작업 경로를 현재 프로세스가 위치한 경로로 변경
+	err := file.ChangeWorkPathToModulePath()
+	if err != nil {
+		return err
+	}
+
+	// 설정 파일 로드 (설정 파일이 없으면 기본 경로 사용)
+	config.Conf.LoadConfig(config.ConfFilePath)
+	return nil
+}
+
+// request 명령 플래그로 인증서 생성 요청 구성
+//
+// Parameters:
+//   - cmd: cobra 명령어 정보 구조체
+//   - usage: 인증서 용도
+//   - cn: 인증서 CN
+//
+// Returns:
+//   - certs.Request: 인증서 생성 요청
+func (c *certOperation) request(cmd *cobra.Command, usage, cn string) certs.Request {
+	hosts, _ := cmd.Flags().GetStringSlice("host")
+	days, _ := cmd.Flags().GetInt("days")
+	keyType, _ := cmd.Flags().GetString("key-type")
+
+	return certs.Request{
+		CommonName: cn,
+		Hosts:      hosts,
+		Usage:      usage,
+		KeyType:    keyType,
+		Validity:   time.Duration(days) * 24 * time.Hour,
+	}
+}
+
+// write 생성된 인증서 저장 및 결과 출력
+//
+// Parameters:
+//   - cmd: cobra 명령어 정보 구조체
+//   - issued: 생성된 인증서 및 키
+//   - certFile: 인증서 파일 경로
+//   - keyFile: 개인키 파일 경로
+//
+// Returns:
+//   - error: 정상 종료(nil), 비정상 종료(error)
+func (c *certOperation) write(cmd *cobra.Command, issued *certs.Issued, certFile, keyFile string) error {
+	force, _ := cmd.Flags().GetBool("force")
+	if err := certs.WriteFiles(issued, certFile, keyFile, force); err != nil {
+		if errors.Is(err, certs.ErrExists) {
+			err = fmt.Errorf("%v (use --force to overwrite)", err)
+		}
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	fmt.Fprintf(os.Stdout, "[INFO] certificate written (cert:%s, key:%s, subject:%s, not after:%s)\n",
+		certFile, keyFile, issued.Cert.Subject.String(), issued.Cert.NotAfter.Format(time.RFC3339))
+	return nil
+}
+
+// selfSigned 자체 서명 서버 인증서 생성
+//
+// Parameters:
+//   - cmd: cobra 명령어 정보 구조체
+//   - args: 위치 인자 (사용 안함)
+//
+// Returns:
+//   - error: 정상 종료(nil), 비정상 종료(error)
+func (c *certOperation) selfSigned(cmd *cobra.Command, args []string) error {
+	if err := c.loadConfig(); err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	req := c.request(cmd, certs.UsageServer, "")
+	if len(req.Hosts) == 0 {
+		req.Hosts = certs.DefaultHosts()
+	}
+	req.CommonName, _ = cmd.Flags().GetString("cn")
+	if req.CommonName == "" {
+		req.CommonName = req.Hosts[0]
+	}
+
+	issued, err := certs.Generate(req, nil, nil)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	// 출력 경로 미지정 시 설정 파일의 서버 인증서 경로 사용
+	certFile, _ := cmd.Flags().GetString("cert")
+	keyFile, _ := cmd.Flags().GetString("key")
+	if certFile == "" {
+		certFile = serverCertFile()
+	}
+	if keyFile == "" {
+		keyFile = config.Conf.Server.TLSPrivateKeyFile
+		if keyFile == "" {
+			keyFile = defaultServerKeyFile
+		}
+	}
+
+	return c.write(cmd, issued, certFile, keyFile)
+}
+
+// createCA 인증서 발급용 로컬 CA 생성
+//
+// Parameters:
+//   - cmd: cobra 명령어 정보 구조체
+//   - args: 위치 인자 (사용 안함)
+//
+// Returns:
+//   - error: 정상 종료(nil), 비정상 종료(error)
+func (c *certOperation) createCA(cmd *cobra.Command, args []string) error {
+	if err := c.loadConfig(); err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	cn, _ := cmd.Flags().GetString("cn")
+	issued, err := certs.Generate(c.request(cmd, certs.UsageCA, cn), nil, nil)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	certFile, _ := cmd.Flags().GetString("cert")
+	keyFile, _ := cmd.Flags().GetString("key")
+	if err := c.write(cmd, issued, certFile, keyFile); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stdout, "[INFO] set server.clientCAFile to %s to trust client certificates issued by this CA\n",
+		certFile)
+	return nil
+}
+
+// issue 로컬 CA로 서버 또는 클라이언트 인증서 발급
+//
+// Parameters:
+//   - cmd: cobra 명령어 정보 구조체
+//   - args: 인증서 CN
+//
+// Returns:
+//   - error: 정상 종료(nil), 비정상 종료(error)
+func (c *certOperation) issue(cmd *cobra.Command, args []string) error {
+	if err := c.loadConfig(); err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	usage := certs.UsageServer
+	if client, _ := cmd.Flags().GetBool("client"); client {
+		usage = certs.UsageClient
+	}
+
+	caCertFile, _ := cmd.Flags().GetString("ca-cert")
+	caKeyFile, _ := cmd.Flags().GetString("ca-key")
+	ca, caKey, err := certs.LoadCA(caCertFile, caKeyFile)
+	if err != nil {
+		err = fmt.Errorf("failed to load CA: %v (create one with 'unisys cert ca')", err)
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	issued, err := certs.Generate(c.request(cmd, usage, args[0]), ca, caKey)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	// 출력 경로 미지정 시 CA와 같은 디렉터리에 CN 이름으로 저장
+	certFile, _ := cmd.Flags().GetString("cert")
+	keyFile, _ := cmd.Flags().GetString("key")
+	base := filepath.Join(filepath.Dir(caCertFile), certFileNamePattern.ReplaceAllString(args[0], "_"))
+	if certFile == "" {
+		certFile = base + ".crt"
+	}
+	if keyFile == "" {
+		keyFile = base + ".key"
+	}
+
+	return c.write(cmd, issued, certFile, keyFile)
+}
+
+// show 인증서 파일 정보 출력
+//
+// Parameters:
+//   - cmd: cobra 명령어 정보 구조체
+//   - args: 인증서 파일 경로
+//
+// Returns:
+//   - error: 정상 종료(nil), 비정상 종료(error)
+func (c *certOperation) show(cmd *cobra.Command, args []string) error {
+	if err := c.loadConfig(); err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	path := serverCertFile()
+	if len(args) > 0 {
+		path = args[0]
+	}
+
+	list, err := certs.ReadCertificates(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for i, cert := range list {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fingerprint := sha256.Sum256(cert.Raw)
+		fmt.Fprintf(w, "Certificate:\t#%d in %s\n", i+1, path)
+		fmt.Fprintf(w, "Subject:\t%s\n", cert.Subject.String())
+		fmt.Fprintf(w, "Issuer:\t%s\n", cert.Issuer.String())
+		fmt.Fprintf(w, "Serial:\t%s\n", cert.SerialNumber.Text(16))
+		fmt.Fprintf(w, "Not Before:\t%s\n", cert.NotBefore.Format(time.RFC3339))
+		fmt.Fprintf(w, "Not After:\t%s (%s)\n", cert.NotAfter.Format(time.RFC3339), expiryText(cert.NotAfter))
+		fmt.Fprintf(w, "Names:\t%s\n", strings.Join(certNames(cert), ", "))
+		fmt.Fprintf(w, "Key:\t%s\n", publicKeyText(cert))
+		fmt.Fprintf(w, "Usage:\t%s\n", usageText(cert))
+		fmt.Fprintf(w, "SHA-256:\t%s\n", strings.ToUpper(hex.EncodeToString(fingerprint[:])))
+	}
+	return w.Flush()
+}
+
+// serverCertFile 설정 파일의 서버 인증서 경로 (미설정 시 기본 경로)
+//
+// Returns:
+//   - string: 인증서 파일 경로
+func serverCertFile() string {
+	if config.Conf.Server.TLSCertificateFile != "" {
+		return config.Conf.Server.TLSCertificateFile
+	}
+	return defaultServerCertFile
+}
+
+// certNames 인증서 SAN 목록
+//
+// Parameters:
+//   - cert: 인증서
+//
+// Returns:
+//   - []string: DNS, IP, URI SAN 목록
+func certNames(cert *x509.Certificate) []string {
+	names := append([]string(nil), cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		names = append(names, ip.String())
+	}
+	for _, uri := range cert.URIs {
+		names = append(names, uri.String())
+	}
+	if len(names) == 0 {
+		return []string{"-"}
+	}
+	return names
+}
+
+// expiryText 인증서 남은 유효 기간 문자열
+//
+// Parameters:
+//   - notAfter: 인증서 만료 시간
+//
+// Returns:
+//   - string: 남은 기간 또는 만료 표시
+func expiryText(notAfter time.Time) string {
+	remain := time.Until(notAfter)
+	if remain <= 0 {
+		return "expired"
+	}
+	return fmt.Sprintf("expires in %d days", int(remain.Hours()/24))
+}
+
+// publicKeyText 인증서 공개키 종류 문자열
+//
+// Parameters:
+//   - cert: 인증서
+//
+// Returns:
+//   - string: 공개키 종류 및 크기
+func publicKeyText(cert *x509.Certificate) string {
+	switch key := cert.PublicKey.(type) {
+	case *ecdsa.PublicKey:
+		return "ECDSA " + key.Curve.Params().Name
+	case *rsa.PublicKey:
+		return fmt.Sprintf("RSA %d", key.N.BitLen())
+	case ed25519.PublicKey:
+		return "Ed25519"
+	default:
+		return cert.PublicKeyAlgorithm.String()
+	}
+}
+
+// usageText 인증서 용도 문자열
+//
+// Parameters:
+//   - cert: 인증서
+//
+// Returns:
+//   - string: CA 여부 및 확장 키 용도
+func usageText(cert *x509.Certificate) string {
+	var usages []string
+	if cert.IsCA {
+		usages = append(usages, "CA")
+	}
+	for _, usage := range cert.ExtKeyUsage {
+		switch usage {
+		case x509.ExtKeyUsageServerAuth:
+			usages = append(usages, "server auth")
+		case x509.ExtKeyUsageClientAuth:
+			usages = append(usages, "client auth")
+		default:
+			usages = append(usages, fmt.Sprintf("ext(%d)", usage))
+		}
+	}
+	if len(usages) == 0 {
+		return "-"
+	}
+	return strings.Join(usages, ", ")
+}
+
+var certOper certOperation
+
+// certCmd 인증서 관리 명령
+var certCmd = &cobra.Command{
+	Use:   "cert",
+	Short: "Generate and inspect TLS certificates",
+}
+
+// certSelfSignedCmd 자체 서명 인증서 생성 명령
+var certSelfSignedCmd = &cobra.Command{
+	Use:   "selfsigned",
+	Short: "Generate a self-signed server certificate (default: tlsCertificateFile/tlsPrivateKeyFile)",
+	Args:  cobra.NoArgs,
+	RunE:  WrapArgsCommandFuncForCobra(auditedCommand(certOper.selfSigned)),
+}
+
+// certCACmd 로컬 CA 생성 명령
+var certCACmd = &cobra.Command{
+	Use:   "ca",
+	Short: "Create a local CA for issuing server and client certificates",
+	Args:  cobra.NoArgs,
+	RunE:  WrapArgsCommandFuncForCobra(auditedCommand(certOper.createCA)),
+}
+
+// certIssueCmd 로컬 CA 인증서 발급 명령
+var certIssueCmd = &cobra.Command{
+	Use:   "issue <common-name>",
+	Short: "Issue a server (default) or client certificate from the local CA",
+	Args:  cobra.ExactArgs(1),
+	RunE:  WrapArgsCommandFuncForCobra(auditedCommand(certOper.issue)),
+}
+
+// certShowCmd 인증서 정보 출력 명령
+var certShowCmd = &cobra.Command{
+	Use:   "show [file]",
+	Short: "Show certificate details (default: tlsCertificateFile)",
+	Args:  cobra.MaximumNArgs(1),
+	RunE:  WrapArgsCommandFuncForCobra(certOper.show),
+}
+
+// init 인증서 명령 초기화
+func init() {
+	keyTypeUsage := "key type (" + certs.KeyECDSA + ", " + certs.KeyRSA + ")"
+	hostUsage := "subject alternative name, repeatable (DNS name, IP or URI)"
+
+	certSelfSignedCmd.Flags().String("cn", "", "common name (default: first host)")
+	certSelfSignedCmd.Flags().StringSlice("host", nil, hostUsage+" (default: hostname, localhost, 127.0.0.1, ::1)")
+	certSelfSignedCmd.Flags().Int("days", 365, "validity in days")
+	certSelfSignedCmd.Flags().String("key-type", certs.KeyECDSA, keyTypeUsage)
+	certSelfSignedCmd.Flags().String("cert", "", "certificate output file (default: tlsCertificateFile)")
+	certSelfSignedCmd.Flags().String("key", "", "private key output file (default: tlsPrivateKeyFile)")
+	certSelfSignedCmd.Flags().Bool("force", false, "overwrite existing files")
+
+	certCACmd.Flags().String("cn", config.ModuleName+" local CA", "common name")
+	certCACmd.Flags().Int("days", 3650, "validity in days")
+	certCACmd.Flags().String("key-type", certs.KeyECDSA, keyTypeUsage)
+	certCACmd.Flags().String("cert", defaultCACertFile, "CA certificate output file")
+	certCACmd.Flags().String("key", defaultCAKeyFile, "CA private key output file")
+	certCACmd.Flags().Bool("force", false, "overwrite existing files")
+
+	certIssueCmd.Flags().Bool("client", false, "issue a client certificate for mTLS instead of a server certificate")
+	certIssueCmd.Flags().StringSlice("host", nil, hostUsage+" (server default: common name)")
+	certIssueCmd.Flags().Int("days", 365, "validity in days (capped at the CA expiry)")
+	certIssueCmd.Flags().String("key-type", certs.KeyECDSA, keyTypeUsage)
+	certIssueCmd.Flags().String("ca-cert", defaultCACertFile, "CA certificate file")
+	certIssueCmd.Flags().String("ca-key", defaultCAKeyFile, "CA private key file")
+	certIssueCmd.Flags().String("cert", "", "certificate output file (default: <common-name>.crt next to the CA)")
+	certIssueCmd.Flags().String("key", "", "private key output file (default: <common-name>.key next to the CA)")
+	certIssueCmd.Flags().Bool("force", false, "overwrite existing files")
+
+	certCmd.AddCommand(certSelfSignedCmd)
+	certCmd.AddCommand(certCACmd)
+	certCmd.AddCommand(certIssueCmd)
+	certCmd.AddCommand(certShowCmd)
+}
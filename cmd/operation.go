@@ -37,13 +37,15 @@ import (
 type unisysOperation struct{}
 
 // start unisys 모듈 가동
+// 감사 로그는 프로세스 종료 시 기록되며 처리 시간은 가동 시간
 //
 // Parameters:
 //   - cmd: cobra 명령어 정보 구조체
+//   - args: 위치 인자 (사용 안함)
 //
 // Returns:
 //   - error: 정상 종료(nil), 비정상 종료(error)
-func (u *unisysOperation) start(cmd *cobra.Command) error {
+func (u *unisysOperation) start(cmd *cobra.Command, args []string) error {
 	// 작업 경로를 현재 프로세스가 위치한 경로로 변경
 	err := file.ChangeWorkPathToModulePath()
 	if err != nil {
@@ -119,10 +121,11 @@ func (u *unisysOperation) start(cmd *cobra.Command) error {
 //
 // Parameters:
 //   - cmd: cobra 명령어 정보 구조체
+//   - args: 위치 인자 (사용 안함)
 //
 // Returns:
 //   - error: 정상 종료(nil), 비정상 종료(error)
-func (u *unisysOperation) stop(cmd *cobra.Command) error {
+func (u *unisysOperation) stop(cmd *cobra.Command, args []string) error {
 	// 작업 경로를 현재 프로세스가 위치한 경로로 변경
 	err := file.ChangeWorkPathToModulePath()
 	if err != nil {
@@ -181,21 +184,21 @@ var unisysOper unisysOperation
 var startCmd = &cobra.Command{
 	Use:   "start",
 	Short: "Run unisys (normal mode)",
-	RunE:  WrapCommandFuncForCobra(unisysOper.start),
+	RunE:  WrapArgsCommandFuncForCobra(auditedCommand(unisysOper.start)),
 }
 
 // debugCmd unisys 시작 명령 (디버그)
 var debugCmd = &cobra.Command{
 	Use:   "debug",
 	Short: "Run unisys (debug mode)",
-	RunE:  WrapCommandFuncForCobra(unisysOper.start),
+	RunE:  WrapArgsCommandFuncForCobra(auditedCommand(unisysOper.start)),
 }
 
 // stopCmd unisys 정지 명령
 var stopCmd = &cobra.Command{
 	Use:   "stop",
 	Short: "Stop unisys",
-	RunE:  WrapCommandFuncForCobra(unisysOper.stop),
+	RunE:  WrapArgsCommandFuncForCobra(auditedCommand(unisysOper.stop)),
 }
 
 // panicHandler 패닉 핸들러
@@ -0,0 +1,194 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/meloncoffee/unisys/config"
+	"github.com/meloncoffee/unisys/internal/auth"
+	"github.com/meloncoffee/unisys/pkg/util/file"
+	"github.com/spf13/cobra"
+)
+
+// tokenOperation API 토큰 관리 명령 구조체
+type tokenOperation struct{}
+
+// openTokenStore 설정 파일에 지정된 토큰 저장소 열기
+//
+// Returns:
+//   - *auth.TokenStore: 토큰 저장소
+//   - error: 성공(nil), 실패(error)
+func (t *tokenOperation) openTokenStore() (*auth.TokenStore, error) {
+	// 작업 경로를 현재 프로세스가 위치한 경로로 변경
+	err := file.ChangeWorkPathToModulePath()
+	if err != nil {
+		return nil, err
+	}
+
+	// 설정 파일 로드 (설정 파일이 없으면 기본 경로 사용)
+	config.Conf.LoadConfig(config.ConfFilePath)
+
+	return auth.NewTokenStore(config.Conf.Auth.TokenFile), nil
+}
+
+// create API 토큰 발급
+//
+// Parameters:
+//   - cmd: cobra 명령어 정보 구조체
+//   - args: 위치 인자 (사용 안함)
+//
+// Returns:
+//   - error: 정상 종료(nil), 비정상 종료(error)
+func (t *tokenOperation) create(cmd *cobra.Command, args []string) error {
+	name, _ := cmd.Flags().GetString("name")
+	roles, _ := cmd.Flags().GetStringSlice("role")
+	scopes, _ := cmd.Flags().GetStringSlice("scope")
+	ttl, _ := cmd.Flags().GetDuration("ttl")
+
+	ts, err := t.openTokenStore()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	plain, token, err := ts.Create(name, roles, scopes, ttl)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	fmt.Fprintf(os.Stdout, "[INFO] token created (id:%s, name:%s, roles:%s, scopes:%s)\n",
+		token.ID, token.Name, strings.Join(token.Roles, ","), strings.Join(token.Scopes, ","))
+	fmt.Fprintf(os.Stdout, "[INFO] store this token now, it cannot be shown again\n%s\n", plain)
+
+	return nil
+}
+
+// list API 토큰 목록 출력
+//
+// Parameters:
+//   - cmd: cobra 명령어 정보 구조체
+//   - args: 위치 인자 (사용 안함)
+//
+// Returns:
+//   - error: 정상 종료(nil), 비정상 종료(error)
+func (t *tokenOperation) list(cmd *cobra.Command, args []string) error {
+	ts, err := t.openTokenStore()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	tokens, err := ts.List()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tROLES\tSCOPES\tCREATED\tEXPIRES")
+	for _, token := range tokens {
+		expires := "never"
+		if token.ExpiresAt != nil {
+			expires = token.ExpiresAt.Format(time.RFC3339)
+			if time.Now().After(*token.ExpiresAt) {
+				expires += " (expired)"
+			}
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", token.ID, token.Name,
+			strings.Join(token.Roles, ","), strings.Join(token.Scopes, ","),
+			token.CreatedAt.Format(time.RFC3339), expires)
+	}
+
+	return w.Flush()
+}
+
+// revoke API 토큰 폐기
+//
+// Parameters:
+//   - cmd: cobra 명령어 정보 구조체
+//   - args: 폐기할 토큰 ID
+//
+// Returns:
+//   - error: 정상 종료(nil), 비정상 종료(error)
+func (t *tokenOperation) revoke(cmd *cobra.Command, args []string) error {
+	ts, err := t.openTokenStore()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	if err := ts.Revoke(args[0]); err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	fmt.Fprintf(os.Stdout, "[INFO] token revoked (id:%s)\n", args[0])
+	return nil
+}
+
+var tokenOper tokenOperation
+
+// tokenCmd API 토큰 관리 명령
+var tokenCmd = &cobra.Command{
+	Use:   "token",
+	Short: "Manage API tokens",
+}
+
+// tokenCreateCmd API 토큰 발급 명령
+var tokenCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create an API token",
+	Args:  cobra.NoArgs,
+	RunE:  WrapArgsCommandFuncForCobra(auditedCommand(tokenOper.create)),
+}
+
+// tokenListCmd API 토큰 목록 명령
+var tokenListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List API tokens",
+	Args:  cobra.NoArgs,
+	RunE:  WrapArgsCommandFuncForCobra(tokenOper.list),
+}
+
+// tokenRevokeCmd API 토큰 폐기 명령
+var tokenRevokeCmd = &cobra.Command{
+	Use:   "revoke <id>",
+	Short: "Revoke an API token",
+	Args:  cobra.ExactArgs(1),
+	RunE:  WrapArgsCommandFuncForCobra(auditedCommand(tokenOper.revoke)),
+}
+
+// init 토큰 명령 초기화
+func init() {
+	tokenCreateCmd.Flags().String("name", "", "token name (required)")
+	tokenCreateCmd.Flags().StringSlice("role", nil,
+		"token role, repeatable (viewer, operator, admin or a custom rbac role)")
+	tokenCreateCmd.Flags().StringSlice("scope", nil,
+		"token scope, repeatable ("+strings.Join(auth.KnownScopes, ", ")+")")
+	tokenCreateCmd.Flags().Duration("ttl", 0, "token lifetime, e.g. 720h (0 means no expiry)")
+	tokenCreateCmd.MarkFlagRequired("name")
+
+	tokenCmd.AddCommand(tokenCreateCmd)
+	tokenCmd.AddCommand(tokenListCmd)
+	tokenCmd.AddCommand(tokenRevokeCmd)
+}
@@ -57,6 +57,10 @@ func init() {
 	unisysCmd.AddCommand(startCmd)
 	unisysCmd.AddCommand(debugCmd)
 	unisysCmd.AddCommand(stopCmd)
+	unisysCmd.AddCommand(tokenCmd)
+	unisysCmd.AddCommand(userCmd)
+	unisysCmd.AddCommand(auditCmd)
+	unisysCmd.AddCommand(certCmd)
 }
 
 // Execute 명령어 실행
@@ -89,6 +93,20 @@ func WrapCommandFuncForCobra(function func(cmd *cobra.Command) error) func(cmd *
 	}
 }
 
+// WrapArgsCommandFuncForCobra 위치 인자를 사용하는 cobra.Command의 RunE 필드 랩핑 함수
+//
+// Parameters:
+//   - function: 명령어 함수
+//
+// Returns:
+//   - error: 정상 종료(nil), 비정상 종료(error)
+func WrapArgsCommandFuncForCobra(function func(cmd *cobra.Command, args []string) error) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceErrors = true
+		return function(cmd, args)
+	}
+}
+
 // IsRunning 모듈이 동작 중인지 확인
 //
 // Parameters:
@@ -132,7 +150,7 @@ func SetupSignal() chan os.Signal {
 	sigChan := make(chan os.Signal, 1)
 	// 수신할 시그널 설정 (SIGINT, SIGTERM, SIGUSR1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM, syscall.SIGUSR1)
-	// 무시할 시그널 설정
+	// 무시할 시그널 설정 (SIGHUP은 TLS 사용 시 서버가 인증서 재로드 용도로 수신)
 	signal.Ignore(syscall.SIGABRT, syscall.SIGALRM, syscall.SIGFPE, syscall.SIGHUP,
 		syscall.SIGILL, syscall.SIGPROF, syscall.SIGQUIT, syscall.SIGTSTP,
 		syscall.SIGVTALRM)
@@ -0,0 +1,214 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/meloncoffee/unisys/config"
+	"github.com/meloncoffee/unisys/internal/auth"
+	"github.com/meloncoffee/unisys/pkg/util/file"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// userOperation 로그인 사용자 관리 명령 구조체
+type userOperation struct{}
+
+// openUserStore 설정 파일에 지정된 사용자 저장소 열기
+//
+// Returns:
+//   - *auth.UserStore: 사용자 저장소
+//   - error: 성공(nil), 실패(error)
+func (u *userOperation) openUserStore() (*auth.UserStore, error) {
+	// 작업 경로를 현재 프로세스가 위치한 경로로 변경
+	err := file.ChangeWorkPathToModulePath()
+	if err != nil {
+		return nil, err
+	}
+
+	// 설정 파일 로드 (설정 파일이 없으면 기본 경로 사용)
+	config.Conf.LoadConfig(config.ConfFilePath)
+
+	return auth.NewUserStore(config.Conf.Auth.UserFile), nil
+}
+
+// readPassword 비밀번호 입력
+// 터미널이면 확인 입력까지 에코 없이 받고, 아니면 표준 입력의 첫 줄을 사용
+//
+// Returns:
+//   - string: 비밀번호
+//   - error: 성공(nil), 실패(error)
+func (u *userOperation) readPassword() (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && line == "" {
+			return "", fmt.Errorf("failed to read password from stdin: %v", err)
+		}
+		return strings.TrimRight(line, "\r\n"), nil
+	}
+
+	fmt.Fprint(os.Stderr, "Password: ")
+	first, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", fmt.Errorf("failed to read password: %v", err)
+	}
+	fmt.Fprint(os.Stderr, "Confirm password: ")
+	second, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", fmt.Errorf("failed to read password: %v", err)
+	}
+	if string(first) != string(second) {
+		return "", fmt.Errorf("passwords do not match")
+	}
+
+	return string(first), nil
+}
+
+// add 로그인 사용자 추가
+//
+// Parameters:
+//   - cmd: cobra 명령어 정보 구조체
+//   - args: 사용자명
+//
+// Returns:
+//   - error: 정상 종료(nil), 비정상 종료(error)
+func (u *userOperation) add(cmd *cobra.Command, args []string) error {
+	roles, _ := cmd.Flags().GetStringSlice("role")
+
+	us, err := u.openUserStore()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	password, err := u.readPassword()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	if err := us.Add(args[0], password, roles); err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	fmt.Fprintf(os.Stdout, "[INFO] user added (name:%s, roles:%s)\n", args[0], strings.Join(roles, ","))
+	return nil
+}
+
+// passwd 로그인 사용자 비밀번호 변경
+//
+// Parameters:
+//   - cmd: cobra 명령어 정보 구조체
+//   - args: 사용자명
+//
+// Returns:
+//   - error: 정상 종료(nil), 비정상 종료(error)
+func (u *userOperation) passwd(cmd *cobra.Command, args []string) error {
+	us, err := u.openUserStore()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	password, err := u.readPassword()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	if err := us.SetPassword(args[0], password); err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	fmt.Fprintf(os.Stdout, "[INFO] password changed (name:%s)\n", args[0])
+	return nil
+}
+
+// del 로그인 사용자 삭제
+//
+// Parameters:
+//   - cmd: cobra 명령어 정보 구조체
+//   - args: 사용자명
+//
+// Returns:
+//   - error: 정상 종료(nil), 비정상 종료(error)
+func (u *userOperation) del(cmd *cobra.Command, args []string) error {
+	us, err := u.openUserStore()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	if err := us.Delete(args[0]); err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	fmt.Fprintf(os.Stdout, "[INFO] user deleted (name:%s)\n", args[0])
+	return nil
+}
+
+var userOper userOperation
+
+// userCmd 로그인 사용자 관리 명령
+var userCmd = &cobra.Command{
+	Use:   "user",
+	Short: "Manage login users",
+}
+
+// userAddCmd 로그인 사용자 추가 명령
+var userAddCmd = &cobra.Command{
+	Use:   "add <name>",
+	Short: "Add a login user (password is read from the terminal or stdin)",
+	Args:  cobra.ExactArgs(1),
+	RunE:  WrapArgsCommandFuncForCobra(auditedCommand(userOper.add)),
+}
+
+// userPasswdCmd 로그인 사용자 비밀번호 변경 명령
+var userPasswdCmd = &cobra.Command{
+	Use:   "passwd <name>",
+	Short: "Change a login user's password",
+	Args:  cobra.ExactArgs(1),
+	RunE:  WrapArgsCommandFuncForCobra(auditedCommand(userOper.passwd)),
+}
+
+// userDelCmd 로그인 사용자 삭제 명령
+var userDelCmd = &cobra.Command{
+	Use:   "del <name>",
+	Short: "Delete a login user",
+	Args:  cobra.ExactArgs(1),
+	RunE:  WrapArgsCommandFuncForCobra(auditedCommand(userOper.del)),
+}
+
+// init 사용자 명령 초기화
+func init() {
+	userAddCmd.Flags().StringSlice("role", nil,
+		"user role, repeatable (viewer, operator, admin or a custom rbac role)")
+
+	userCmd.AddCommand(userAddCmd)
+	userCmd.AddCommand(userPasswdCmd)
+	userCmd.AddCommand(userDelCmd)
+}
@@ -21,7 +21,9 @@ package config
 
 import (
 	"fmt"
+	"math"
 	"os"
+	"strings"
 
 	"gopkg.in/yaml.v3"
 )
@@ -33,29 +35,83 @@ var (
 )
 
 const (
-	ConfFilePath = "conf/unisys.yaml"
-	PidFilePath  = "var/unisys.pid"
-	LogFilePath  = "log/unisys.log"
+	ConfFilePath     = "conf/unisys.yaml"
+	PidFilePath      = "var/unisys.pid"
+	TokenFilePath    = "conf/tokens.yaml"
+	UserFilePath     = "conf/users.yaml"
+	LogFilePath      = "log/unisys.log"
+	AuditFilePath    = "log/audit.log"
+	AuditKeyFilePath = "conf/audit.key"
+)
+
+// HTTP 요청 메트릭 기본 히스토그램 버킷
+var (
+	DefaultDurationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+	DefaultSizeBuckets     = []float64{100, 1000, 10000, 100000, 1000000, 10000000}
 )
 
 // Config 전역 설정 정보 구조체
 type Config struct {
 	// 서버 설정
 	Server struct {
-		// 서버 리슨 포트 (DEF:8443, MIN:1, MAX:65535)
+		// 서버 리슨 포트 (DEF:8443, MIN:1, MAX:65535, listeners 설정 시 무시)
 		Port int `yaml:"port"`
 		// 서버 셧다운 타임아웃 (DEF:5sec, MIN:1sec, MAX:20sec)
 		ShutdownTimeout int `yaml:"shutdownTimeout"`
+		// 요청 읽기 타임아웃(초) (DEF:10sec, MIN:1sec, MAX:3600sec)
+		ReadTimeout int `yaml:"readTimeout"`
+		// 응답 쓰기 타임아웃(초) (DEF:10sec, MIN:1sec, MAX:3600sec)
+		WriteTimeout int `yaml:"writeTimeout"`
+		// 요청 헤더 최대 크기(byte) (DEF:1048576, MIN:4096, MAX:16777216)
+		MaxHeaderBytes int `yaml:"maxHeaderBytes"`
 		// TLS 사용 설정 (DEF:false)
 		TLSEnabled bool `yaml:"tlsEnabled"`
 		// TLS 인증서 파일 경로
 		TLSCertificateFile string `yaml:"tlsCertificateFile"`
 		// 서버 Private Key 파일 경로
 		TLSPrivateKeyFile string `yaml:"tlsPrivateKeyFile"`
+		// 인증서/키 파일이 없으면 자체 서명 인증서 자동 생성 (DEF:false)
+		TLSAutoGenerate bool `yaml:"tlsAutoGenerate"`
+		// SNI로 선택할 추가 인증서/키 파일 목록
+		TLSCertificates []TLSCertificateYaml `yaml:"tlsCertificates"`
+		// 인증서 파일 변경 확인 주기(초) (DEF:60sec, MIN:5sec, MAX:3600sec)
+		TLSReloadInterval int `yaml:"tlsReloadInterval"`
+		// TLS 버전, 암호 스위트, 키 교환 곡선 정책
+		TLS TLSPolicyYaml `yaml:"tls"`
+		// HTTP/2 설정
+		HTTP2 HTTP2Yaml `yaml:"http2"`
+		// HTTP/3(QUIC) 설정
+		HTTP3 HTTP3Yaml `yaml:"http3"`
+		// 클라이언트 인증서 검증용 CA 파일 경로
+		ClientCAFile string `yaml:"clientCAFile"`
+		// 클라이언트 인증서 요구 방식 (DEF:none, none/request/require/verify)
+		ClientAuth string `yaml:"clientAuth"`
+		// 허용할 클라이언트 인증서 subject/SAN 패턴 목록 (비어 있으면 CA가 검증한 모든 인증서 허용)
+		AllowedClients []string `yaml:"allowedClients"`
+		// 클라이언트 인증서 패턴별 역할 목록
+		ClientRoles map[string][]string `yaml:"clientRoles"`
+		// X-Forwarded-For 등 프록시 헤더와 PROXY protocol을 신뢰할 프록시 IP/CIDR 목록 (DEF:없음)
+		TrustedProxies []string `yaml:"trustedProxies"`
+		// 신뢰 프록시로부터 PROXY protocol(v1/v2) 헤더 수신 여부 (DEF:false)
+		ProxyProtocol bool `yaml:"proxyProtocol"`
+		// PROXY protocol 헤더 대기 시간(초) (DEF:5sec, MIN:1sec, MAX:60sec)
+		ProxyHeaderTimeout int `yaml:"proxyHeaderTimeout"`
 		// Let's Encrypt 사용 설정
 		AutoTLS AutoTLSYaml `yaml:"autoTLS"`
+		// 리스너 목록 (비어 있으면 port 및 TLS 설정으로 단일 리스너 구성)
+		Listeners []ListenerYaml `yaml:"listeners"`
 	} `yaml:"server"`
 
+	// 클라이언트 IP 접근 제어 설정
+	Access struct {
+		// 허용 IP/CIDR 목록 (비어 있으면 거부 목록에 없는 모든 IP 허용)
+		Allow []string `yaml:"allow"`
+		// 거부 IP/CIDR 목록 (허용 목록보다 우선)
+		Deny []string `yaml:"deny"`
+		// 라우트 경로(예: /api/v1/metrics)별 추가 규칙 (전역 규칙과 함께 적용)
+		Routes map[string]AccessRuleYaml `yaml:"routes"`
+	} `yaml:"access"`
+
 	// API 설정
 	API struct {
 		// 애플리케이션 메트릭을 제공하는 엔드포인트 (DEF: /metrics)
@@ -64,8 +120,98 @@ type Config struct {
 		HealthURI string `yaml:"healthURI"`
 		// 서버 상태 정보를 제공하는 엔드포인트 (DEF: /sys/stats)
 		SysStatURI string `yaml:"sysStatURI"`
+		// 리소스 고갈 시점 예측 정보를 제공하는 엔드포인트 (DEF: /sys/forecast)
+		ForecastURI string `yaml:"forecastURI"`
 	} `yaml:"api"`
 
+	// HTTP 요청 메트릭 설정
+	HTTPMetrics struct {
+		// 응답 시간 히스토그램 버킷(초, 오름차순) (DEF:0.005 ~ 10)
+		DurationBuckets []float64 `yaml:"durationBuckets"`
+		// 응답 크기 히스토그램 버킷(byte, 오름차순) (DEF:100 ~ 10MB)
+		SizeBuckets []float64 `yaml:"sizeBuckets"`
+	} `yaml:"httpMetrics"`
+
+	// 인증 설정
+	Auth struct {
+		// API 인증 사용 여부 (DEF:false)
+		Enabled bool `yaml:"enabled"`
+		// 토큰(해시) 저장 파일 경로 (DEF:conf/tokens.yaml)
+		TokenFile string `yaml:"tokenFile"`
+		// 사용자(bcrypt 해시) 저장 파일 경로 (DEF:conf/users.yaml)
+		UserFile string `yaml:"userFile"`
+		// 미사용 세션 만료 시간(초) (DEF:1800sec, MIN:60sec, MAX:86400sec)
+		SessionIdleTimeout int `yaml:"sessionIdleTimeout"`
+		// 세션 최대 유지 시간(초) (DEF:43200sec, MIN:300sec, MAX:604800sec)
+		SessionMaxAge int `yaml:"sessionMaxAge"`
+		// 계정 잠금 전 허용되는 연속 로그인 실패 횟수 (DEF:5, MIN:1, MAX:100)
+		MaxLoginFailures int `yaml:"maxLoginFailures"`
+		// 계정 잠금 시간(초) (DEF:900sec, MIN:1sec, MAX:86400sec)
+		LockoutDuration int `yaml:"lockoutDuration"`
+
+		// OpenID Connect SSO 설정
+		OIDC struct {
+			// OIDC 로그인 및 JWT 베어러 토큰 사용 여부 (DEF:false)
+			Enabled bool `yaml:"enabled"`
+			// 발급자 URL (discovery: <issuer>/.well-known/openid-configuration)
+			Issuer string `yaml:"issuer"`
+			// 클라이언트 ID
+			ClientID string `yaml:"clientID"`
+			// 클라이언트 시크릿
+			ClientSecret string `yaml:"clientSecret"`
+			// 인가 코드 콜백 URL (예: https://host:8000/api/v1/oidc/callback)
+			RedirectURL string `yaml:"redirectURL"`
+			// 요청 scope 목록 (openid는 항상 포함) (DEF:openid, profile, email)
+			Scopes []string `yaml:"scopes"`
+			// 사용자명 클레임 (없으면 sub 사용) (DEF:preferred_username)
+			UsernameClaim string `yaml:"usernameClaim"`
+			// 그룹 클레임 (점으로 중첩 클레임 지정 가능, 예: realm_access.roles) (DEF:groups)
+			GroupsClaim string `yaml:"groupsClaim"`
+			// 그룹별 unisys 역할 매핑
+			GroupRoles map[string][]string `yaml:"groupRoles"`
+			// 모든 OIDC 사용자에게 부여할 역할
+			DefaultRoles []string `yaml:"defaultRoles"`
+			// JWT 베어러 토큰에 허용할 audience 목록 (DEF:clientID)
+			Audiences []string `yaml:"audiences"`
+			// 발급자 TLS 인증서 검증용 CA 파일 경로 (미설정 시 시스템 CA 사용)
+			CAFile string `yaml:"caFile"`
+			// 로그인 완료 후 이동할 경로 (DEF:/)
+			PostLoginURL string `yaml:"postLoginURL"`
+		} `yaml:"oidc"`
+	} `yaml:"auth"`
+
+	// 역할 기반 접근 제어 설정
+	RBAC struct {
+		// 사용자 정의 역할 (기본 역할: viewer, operator, admin)
+		Roles map[string]RoleYaml `yaml:"roles"`
+	} `yaml:"rbac"`
+
+	// 실시간 스트리밍(SSE, WebSocket) 설정
+	Stream struct {
+		// 하트비트 전송 주기(초) (DEF:15sec, MIN:1sec, MAX:300sec)
+		HeartbeatInterval int `yaml:"heartbeatInterval"`
+		// 클라이언트별 샘플 버퍼 크기 (초과 시 오래된 샘플부터 버림) (DEF:16, MIN:1, MAX:1024)
+		BufferSize int `yaml:"bufferSize"`
+	} `yaml:"stream"`
+
+	// 요청 속도 및 동시 처리 제한 설정
+	RateLimit struct {
+		// 속도/동시 처리 제한 사용 여부 (DEF:true)
+		Enabled bool `yaml:"enabled"`
+		// 클라이언트 IP별 토큰 버킷 (DEF:rate 20/sec, burst 40)
+		PerIP RateYaml `yaml:"perIP"`
+		// 인증 주체별 토큰 버킷 (DEF:rate 50/sec, burst 100)
+		PerIdentity RateYaml `yaml:"perIdentity"`
+		// 동시 처리 요청 수 상한, 초과 시 503 응답 (DEF:64, MIN:1, MAX:100000)
+		MaxInFlight int `yaml:"maxInFlight"`
+		// 503 응답의 Retry-After 값(초) (DEF:1sec, MIN:1sec, MAX:3600sec)
+		RetryAfter int `yaml:"retryAfter"`
+		// 미사용 클라이언트 버킷 정리 시간(초) (DEF:600sec, MIN:10sec, MAX:86400sec)
+		IdleTimeout int `yaml:"idleTimeout"`
+		// 라우트 경로(예: /api/v1/stream)별 설정 재정의
+		Routes map[string]RateLimitRouteYaml `yaml:"routes"`
+	} `yaml:"rateLimit"`
+
 	// 로그 설정
 	Log struct {
 		// 최대 로그 파일 사이즈 (DEF:100MB, MIN:1MB, MAX:1000MB)
@@ -77,6 +223,97 @@ type Config struct {
 		// 백업 로그 파일 압축 여부 (DEF:true, ENABLE:true, DISABLE:false)
 		CompBakLogFile bool `yaml:"compressBackupLogFile"`
 	} `yaml:"log"`
+
+	// 접근 로그 설정
+	AccessLog struct {
+		// 접근 로그 형식 (DEF:text, text/json)
+		Format string `yaml:"format"`
+		// 접근 로그 파일 경로 (DEF:없음, 서버 로그 파일에 기록)
+		File string `yaml:"file"`
+		// 최대 접근 로그 파일 사이즈 (DEF:100MB, MIN:1MB, MAX:1000MB)
+		MaxLogFileSize int `yaml:"maxLogFileSize"`
+		// 최대 접근 로그 파일 백업 개수 (DEF:10, MIN:1, MAX:100)
+		MaxLogFileBackup int `yaml:"maxLogFileBackup"`
+		// 최대 백업 접근 로그 파일 유지 기간(일) (DEF:30, MIN:1, MAX:365)
+		MaxLogFileAge int `yaml:"maxLogFileAge"`
+		// 백업 접근 로그 파일 압축 여부 (DEF:true)
+		CompBakLogFile bool `yaml:"compressBackupLogFile"`
+	} `yaml:"accessLog"`
+
+	// 감사 로그 설정
+	Audit struct {
+		// 관리 작업(변경 API 호출, CLI 명령) 감사 로그 사용 여부 (DEF:true)
+		Enabled bool `yaml:"enabled"`
+		// 감사 로그 파일 경로 (DEF:log/audit.log)
+		File string `yaml:"file"`
+		// 해시 체인 HMAC 키 파일 경로 (없으면 자동 생성) (DEF:conf/audit.key)
+		KeyFile string `yaml:"keyFile"`
+	} `yaml:"audit"`
+
+	// 이상 탐지 설정
+	Anomaly struct {
+		// 이상 탐지 사용 여부 (DEF:true)
+		Enabled bool `yaml:"enabled"`
+		// EWMA 평활 계수 (DEF:0.1, MIN:0.01, MAX:1.0)
+		Alpha float64 `yaml:"alpha"`
+		// 이상으로 판단할 z-score 임계값 (DEF:3.0, MIN:1.0, MAX:10.0)
+		Sensitivity float64 `yaml:"sensitivity"`
+		// 이상 판단 전 베이스라인 학습에 필요한 최소 샘플 수 (DEF:20, MIN:1, MAX:10000)
+		MinSamples int `yaml:"minSamples"`
+		// 시간대(hour-of-day)별 계절성 베이스라인 사용 여부 (DEF:false)
+		Seasonal bool `yaml:"seasonal"`
+	} `yaml:"anomaly"`
+
+	// 리소스 고갈 시점 예측 설정
+	Forecast struct {
+		// 고갈 시점 예측 사용 여부 (DEF:true)
+		Enabled bool `yaml:"enabled"`
+		// 선형 회귀에 사용할 이력 기간(초) (DEF:3600sec, MIN:300sec, MAX:604800sec)
+		LookbackWindow int `yaml:"lookbackWindow"`
+		// 예측에 필요한 최소 샘플 수 (DEF:10, MIN:2, MAX:1000)
+		MinSamples int `yaml:"minSamples"`
+	} `yaml:"forecast"`
+}
+
+// TLSCertificateYaml TLS 인증서/키 파일 쌍 설정 구조체
+type TLSCertificateYaml struct {
+	// TLS 인증서 파일 경로
+	CertFile string `yaml:"certFile"`
+	// Private Key 파일 경로
+	KeyFile string `yaml:"keyFile"`
+}
+
+// TLSPolicyYaml TLS 정책 설정 구조체
+// 버전, 암호 스위트, 곡선 항목을 지정하면 프리셋 값 대신 사용
+type TLSPolicyYaml struct {
+	// 정책 프리셋 (DEF:intermediate, modern/intermediate/legacy)
+	Preset string `yaml:"preset"`
+	// 최소 TLS 버전 (1.0, 1.1, 1.2, 1.3)
+	MinVersion string `yaml:"minVersion"`
+	// 최대 TLS 버전 (1.0, 1.1, 1.2, 1.3)
+	MaxVersion string `yaml:"maxVersion"`
+	// TLS 1.2 이하 암호 스위트 목록 (IANA 이름, 예: TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256)
+	CipherSuites []string `yaml:"cipherSuites"`
+	// 키 교환 곡선 목록 (X25519, P-256, P-384, P-521)
+	Curves []string `yaml:"curves"`
+}
+
+// HTTP2Yaml HTTP/2 설정 구조체
+type HTTP2Yaml struct {
+	// HTTP/2 사용 여부 (DEF:true, TLS 사용 시에만 적용)
+	Enabled bool `yaml:"enabled"`
+	// 연결당 최대 동시 스트림 수 (DEF:250, MIN:1, MAX:10000)
+	MaxConcurrentStreams int `yaml:"maxConcurrentStreams"`
+}
+
+// HTTP3Yaml HTTP/3(QUIC) 설정 구조체
+type HTTP3Yaml struct {
+	// TLS 리스너와 같은 주소의 UDP 포트로 HTTP/3 제공 여부 (DEF:false)
+	Enabled bool `yaml:"enabled"`
+	// Alt-Svc 헤더의 HTTP/3 광고 유지 시간(초) (DEF:86400sec, MIN:60sec, MAX:2592000sec)
+	AltSvcMaxAge int `yaml:"altSvcMaxAge"`
+	// 유휴 QUIC 연결 종료 시간(초) (DEF:30sec, MIN:1sec, MAX:600sec)
+	MaxIdleTimeout int `yaml:"maxIdleTimeout"`
 }
 
 // AutoTLSYaml Let's Encrypt 설정 구조체
@@ -87,6 +324,90 @@ type AutoTLSYaml struct {
 	CertPath string `yaml:"certPath"`
 	// TLS 인증서를 발급받을 도메인
 	Host string `yaml:"host"`
+	// TLS 인증서를 발급받을 추가 도메인 목록
+	Hosts []string `yaml:"hosts"`
+	// ACME 계정 연락처 이메일
+	Email string `yaml:"email"`
+	// ACME 디렉터리 URL (DEF:Let's Encrypt production)
+	DirectoryURL string `yaml:"directoryURL"`
+	// ACME 서버 TLS 인증서 검증용 CA 파일 경로 (미설정 시 시스템 CA 사용)
+	CAFile string `yaml:"caFile"`
+	// External Account Binding 설정 (ACME 서버가 요구하는 경우)
+	EAB ACMEEABYaml `yaml:"eab"`
+	// HTTP-01 챌린지 및 HTTPS 리다이렉트 리슨 포트 (DEF:80, MIN:0(사용 안함), MAX:65535)
+	HTTPPort int `yaml:"httpPort"`
+	// 챌린지 외 HTTP 요청을 HTTPS로 리다이렉트 (DEF:true)
+	HTTPRedirect bool `yaml:"httpRedirect"`
+}
+
+// ACMEEABYaml ACME External Account Binding 설정 구조체
+type ACMEEABYaml struct {
+	// ACME 서버가 발급한 키 ID
+	KeyID string `yaml:"keyID"`
+	// ACME 서버가 발급한 HMAC 키 (base64url)
+	HMACKey string `yaml:"hmacKey"`
+}
+
+// ListenerYaml 리스너 설정 구조체
+type ListenerYaml struct {
+	// 리스너 이름 (로그 표시용, DEF:address)
+	Name string `yaml:"name"`
+	// 리슨 주소 ([host]:port 또는 unix:/path/to/socket)
+	Address string `yaml:"address"`
+	// TLS 사용 여부 (DEF:auto, auto(tlsEnabled/autoTLS 설정을 따름, 유닉스 소켓은 off)/on/off)
+	TLS string `yaml:"tls"`
+	// 클라이언트 인증서 요구 방식 (DEF:server.clientAuth, none/request/require/verify)
+	ClientAuth string `yaml:"clientAuth"`
+	// 클라이언트 인증서 검증용 CA 파일 경로 (DEF:server.clientCAFile)
+	ClientCAFile string `yaml:"clientCAFile"`
+	// 제공할 라우트 그룹(OpenAPI 태그: system/auth/sys/resources) 또는 경로 패턴 목록 (DEF:all)
+	Routes []string `yaml:"routes"`
+	// 유닉스 소켓 파일 권한 (DEF:0660)
+	SocketMode string `yaml:"socketMode"`
+	// 유닉스 소켓 접속 사용자에게 부여할 역할 목록 (DEF:admin)
+	SocketRoles []string `yaml:"socketRoles"`
+}
+
+// AccessRuleYaml IP 접근 규칙 구조체
+type AccessRuleYaml struct {
+	// 허용 IP/CIDR 목록 (비어 있으면 거부 목록에 없는 모든 IP 허용)
+	Allow []string `yaml:"allow"`
+	// 거부 IP/CIDR 목록 (허용 목록보다 우선)
+	Deny []string `yaml:"deny"`
+}
+
+// RateYaml 토큰 버킷 설정 구조체
+type RateYaml struct {
+	// 초당 허용 요청 수 (0이면 제한 없음)
+	Rate float64 `yaml:"rate"`
+	// 순간 허용 요청 수 (MIN:1)
+	Burst int `yaml:"burst"`
+}
+
+// RateLimitRouteYaml 라우트별 속도/동시 처리 제한 재정의 구조체
+type RateLimitRouteYaml struct {
+	// 클라이언트 IP별 토큰 버킷 (미설정 시 전역 설정 사용)
+	PerIP *RateYaml `yaml:"perIP"`
+	// 인증 주체별 토큰 버킷 (미설정 시 전역 설정 사용)
+	PerIdentity *RateYaml `yaml:"perIdentity"`
+	// 라우트 전용 동시 처리 상한 (0: 전역 상한 공유, 음수: 제한 없음)
+	MaxInFlight int `yaml:"maxInFlight"`
+}
+
+// RoleYaml 역할 정의 구조체
+type RoleYaml struct {
+	// 역할에 부여할 권한 범위 (metrics:read, resources:read, operate, admin)
+	Scopes []string `yaml:"scopes"`
+	// 역할에 부여할 경로/메서드 권한
+	Permissions []PermissionYaml `yaml:"permissions"`
+}
+
+// PermissionYaml 경로/메서드 권한 구조체
+type PermissionYaml struct {
+	// 허용 HTTP 메서드 (비어 있거나 * 이면 전체)
+	Methods []string `yaml:"methods"`
+	// 허용 경로 패턴 (와일드카드 사용 가능, /** 접미어는 하위 경로 전체)
+	Paths []string `yaml:"paths"`
 }
 
 // RunConfig 런타임 전역 설정 정보 구조체
@@ -102,19 +423,81 @@ var RunConf RunConfig
 func init() {
 	Conf.Server.Port = 8443
 	Conf.Server.ShutdownTimeout = 5
+	Conf.Server.ReadTimeout = 10
+	Conf.Server.WriteTimeout = 10
+	Conf.Server.MaxHeaderBytes = 1 << 20
 	Conf.Server.TLSEnabled = false
 	Conf.Server.TLSCertificateFile = ""
 	Conf.Server.TLSPrivateKeyFile = ""
+	Conf.Server.TLSAutoGenerate = false
+	Conf.Server.TLSReloadInterval = 60
+	Conf.Server.TLS.Preset = "intermediate"
+	Conf.Server.HTTP2.Enabled = true
+	Conf.Server.HTTP2.MaxConcurrentStreams = 250
+	Conf.Server.HTTP3.Enabled = false
+	Conf.Server.HTTP3.AltSvcMaxAge = 86400
+	Conf.Server.HTTP3.MaxIdleTimeout = 30
+	Conf.Server.ClientCAFile = ""
+	Conf.Server.ClientAuth = "none"
+	Conf.Server.ProxyProtocol = false
+	Conf.Server.ProxyHeaderTimeout = 5
 	Conf.Server.AutoTLS.Enabled = false
 	Conf.Server.AutoTLS.CertPath = ".cache"
 	Conf.Server.AutoTLS.Host = ""
+	Conf.Server.AutoTLS.DirectoryURL = "https://acme-v02.api.letsencrypt.org/directory"
+	Conf.Server.AutoTLS.HTTPPort = 80
+	Conf.Server.AutoTLS.HTTPRedirect = true
 	Conf.API.MetricURI = "/metrics"
 	Conf.API.HealthURI = "/health"
 	Conf.API.SysStatURI = "/sys/stats"
+	Conf.API.ForecastURI = "/sys/forecast"
+	Conf.HTTPMetrics.DurationBuckets = DefaultDurationBuckets
+	Conf.HTTPMetrics.SizeBuckets = DefaultSizeBuckets
+	Conf.Auth.Enabled = false
+	Conf.Auth.TokenFile = TokenFilePath
+	Conf.Auth.UserFile = UserFilePath
+	Conf.Auth.SessionIdleTimeout = 1800
+	Conf.Auth.SessionMaxAge = 43200
+	Conf.Auth.MaxLoginFailures = 5
+	Conf.Auth.LockoutDuration = 900
+	Conf.Auth.OIDC.Enabled = false
+	Conf.Auth.OIDC.Scopes = []string{"openid", "profile", "email"}
+	Conf.Auth.OIDC.UsernameClaim = "preferred_username"
+	Conf.Auth.OIDC.GroupsClaim = "groups"
+	Conf.Auth.OIDC.PostLoginURL = "/"
+	Conf.Stream.HeartbeatInterval = 15
+	Conf.Stream.BufferSize = 16
+	Conf.RateLimit.Enabled = true
+	Conf.RateLimit.PerIP = RateYaml{Rate: 20, Burst: 40}
+	Conf.RateLimit.PerIdentity = RateYaml{Rate: 50, Burst: 100}
+	Conf.RateLimit.MaxInFlight = 64
+	Conf.RateLimit.RetryAfter = 1
+	Conf.RateLimit.IdleTimeout = 600
+	Conf.RateLimit.Routes = map[string]RateLimitRouteYaml{
+		// 장시간 유지되는 스트림 연결이 전역 상한을 점유하지 않도록 분리
+		"/api/v1/stream": {MaxInFlight: 16},
+	}
 	Conf.Log.MaxLogFileSize = 100
 	Conf.Log.MaxLogFileBackup = 10
 	Conf.Log.MaxLogFileAge = 90
 	Conf.Log.CompBakLogFile = true
+	Conf.AccessLog.Format = "text"
+	Conf.AccessLog.File = ""
+	Conf.AccessLog.MaxLogFileSize = 100
+	Conf.AccessLog.MaxLogFileBackup = 10
+	Conf.AccessLog.MaxLogFileAge = 30
+	Conf.AccessLog.CompBakLogFile = true
+	Conf.Audit.Enabled = true
+	Conf.Audit.File = AuditFilePath
+	Conf.Audit.KeyFile = AuditKeyFilePath
+	Conf.Anomaly.Enabled = true
+	Conf.Anomaly.Alpha = 0.1
+	Conf.Anomaly.Sensitivity = 3.0
+	Conf.Anomaly.MinSamples = 20
+	Conf.Anomaly.Seasonal = false
+	Conf.Forecast.Enabled = true
+	Conf.Forecast.LookbackWindow = 3600
+	Conf.Forecast.MinSamples = 10
 }
 
 // LoadConfig 설정 파일 로드
@@ -148,6 +531,107 @@ func (c *Config) LoadConfig(filePath string) error {
 	if c.Server.ShutdownTimeout < 0 || c.Server.ShutdownTimeout > 20 {
 		c.Server.ShutdownTimeout = 5
 	}
+	// 클라이언트 인증서 요구 방식은 보안 설정이므로 잘못된 값을 보정하지 않고 서버 가동 시 거부
+	if c.Server.ClientAuth == "" {
+		c.Server.ClientAuth = "none"
+	}
+	if c.Server.ProxyHeaderTimeout < 1 || c.Server.ProxyHeaderTimeout > 60 {
+		c.Server.ProxyHeaderTimeout = 5
+	}
+	if c.Server.ReadTimeout < 1 || c.Server.ReadTimeout > 3600 {
+		c.Server.ReadTimeout = 10
+	}
+	if c.Server.WriteTimeout < 1 || c.Server.WriteTimeout > 3600 {
+		c.Server.WriteTimeout = 10
+	}
+	if c.Server.MaxHeaderBytes < 4096 || c.Server.MaxHeaderBytes > 16<<20 {
+		c.Server.MaxHeaderBytes = 1 << 20
+	}
+	if c.Server.AutoTLS.DirectoryURL == "" {
+		c.Server.AutoTLS.DirectoryURL = "https://acme-v02.api.letsencrypt.org/directory"
+	}
+	if c.Server.AutoTLS.HTTPPort < 0 || c.Server.AutoTLS.HTTPPort > 65535 {
+		c.Server.AutoTLS.HTTPPort = 80
+	}
+	if c.Server.TLSReloadInterval < 5 || c.Server.TLSReloadInterval > 3600 {
+		c.Server.TLSReloadInterval = 60
+	}
+	// 알 수 없는 TLS 정책은 더 약한 정책으로 가동되지 않도록 보정하지 않고 서버 가동 시 거부
+	c.Server.TLS.Preset = strings.ToLower(c.Server.TLS.Preset)
+	if c.Server.TLS.Preset == "" {
+		c.Server.TLS.Preset = "intermediate"
+	}
+	for i := range c.Server.Listeners {
+		c.Server.Listeners[i].normalize(c.Server.ClientAuth, c.Server.ClientCAFile)
+	}
+	if c.Server.HTTP2.MaxConcurrentStreams < 1 || c.Server.HTTP2.MaxConcurrentStreams > 10000 {
+		c.Server.HTTP2.MaxConcurrentStreams = 250
+	}
+	if c.Server.HTTP3.AltSvcMaxAge < 60 || c.Server.HTTP3.AltSvcMaxAge > 2592000 {
+		c.Server.HTTP3.AltSvcMaxAge = 86400
+	}
+	if c.Server.HTTP3.MaxIdleTimeout < 1 || c.Server.HTTP3.MaxIdleTimeout > 600 {
+		c.Server.HTTP3.MaxIdleTimeout = 30
+	}
+	if !validBuckets(c.HTTPMetrics.DurationBuckets) {
+		c.HTTPMetrics.DurationBuckets = DefaultDurationBuckets
+	}
+	if !validBuckets(c.HTTPMetrics.SizeBuckets) {
+		c.HTTPMetrics.SizeBuckets = DefaultSizeBuckets
+	}
+	if c.Auth.TokenFile == "" {
+		c.Auth.TokenFile = TokenFilePath
+	}
+	if c.Auth.UserFile == "" {
+		c.Auth.UserFile = UserFilePath
+	}
+	if c.Auth.SessionIdleTimeout < 60 || c.Auth.SessionIdleTimeout > 86400 {
+		c.Auth.SessionIdleTimeout = 1800
+	}
+	if c.Auth.SessionMaxAge < 300 || c.Auth.SessionMaxAge > 604800 {
+		c.Auth.SessionMaxAge = 43200
+	}
+	if c.Auth.MaxLoginFailures < 1 || c.Auth.MaxLoginFailures > 100 {
+		c.Auth.MaxLoginFailures = 5
+	}
+	if c.Auth.LockoutDuration < 1 || c.Auth.LockoutDuration > 86400 {
+		c.Auth.LockoutDuration = 900
+	}
+	if c.Auth.OIDC.UsernameClaim == "" {
+		c.Auth.OIDC.UsernameClaim = "preferred_username"
+	}
+	if c.Auth.OIDC.GroupsClaim == "" {
+		c.Auth.OIDC.GroupsClaim = "groups"
+	}
+	if c.Auth.OIDC.PostLoginURL == "" {
+		c.Auth.OIDC.PostLoginURL = "/"
+	}
+	if c.Stream.HeartbeatInterval < 1 || c.Stream.HeartbeatInterval > 300 {
+		c.Stream.HeartbeatInterval = 15
+	}
+	if c.Stream.BufferSize < 1 || c.Stream.BufferSize > 1024 {
+		c.Stream.BufferSize = 16
+	}
+	c.RateLimit.PerIP.normalize(RateYaml{Rate: 20, Burst: 40})
+	c.RateLimit.PerIdentity.normalize(RateYaml{Rate: 50, Burst: 100})
+	for route, override := range c.RateLimit.Routes {
+		if override.PerIP != nil {
+			override.PerIP.normalize(c.RateLimit.PerIP)
+		}
+		if override.PerIdentity != nil {
+			override.PerIdentity.normalize(c.RateLimit.PerIdentity)
+		}
+		c.RateLimit.Routes[route] = override
+	}
+	if c.RateLimit.MaxInFlight < 1 || c.RateLimit.MaxInFlight > 100000 {
+		c.RateLimit.MaxInFlight = 64
+	}
+	if c.RateLimit.RetryAfter < 1 || c.RateLimit.RetryAfter > 3600 {
+		c.RateLimit.RetryAfter = 1
+	}
+	if c.RateLimit.IdleTimeout < 10 || c.RateLimit.IdleTimeout > 86400 {
+		c.RateLimit.IdleTimeout = 600
+	}
 	if c.Log.MaxLogFileSize < 1 || c.Log.MaxLogFileSize > 1000 {
 		c.Log.MaxLogFileSize = 100
 	}
@@ -157,6 +641,104 @@ func (c *Config) LoadConfig(filePath string) error {
 	if c.Log.MaxLogFileAge < 1 || c.Log.MaxLogFileAge > 365 {
 		c.Log.MaxLogFileAge = 90
 	}
+	c.AccessLog.Format = strings.ToLower(c.AccessLog.Format)
+	if c.AccessLog.Format != "json" {
+		c.AccessLog.Format = "text"
+	}
+	if c.AccessLog.MaxLogFileSize < 1 || c.AccessLog.MaxLogFileSize > 1000 {
+		c.AccessLog.MaxLogFileSize = 100
+	}
+	if c.AccessLog.MaxLogFileBackup < 1 || c.AccessLog.MaxLogFileBackup > 100 {
+		c.AccessLog.MaxLogFileBackup = 10
+	}
+	if c.AccessLog.MaxLogFileAge < 1 || c.AccessLog.MaxLogFileAge > 365 {
+		c.AccessLog.MaxLogFileAge = 30
+	}
+	if c.Audit.File == "" {
+		c.Audit.File = AuditFilePath
+	}
+	if c.Audit.KeyFile == "" {
+		c.Audit.KeyFile = AuditKeyFilePath
+	}
+	if c.Anomaly.Alpha < 0.01 || c.Anomaly.Alpha > 1.0 {
+		c.Anomaly.Alpha = 0.1
+	}
+	if c.Anomaly.Sensitivity < 1.0 || c.Anomaly.Sensitivity > 10.0 {
+		c.Anomaly.Sensitivity = 3.0
+	}
+	if c.Anomaly.MinSamples < 1 || c.Anomaly.MinSamples > 10000 {
+		c.Anomaly.MinSamples = 20
+	}
+	if c.Forecast.LookbackWindow < 300 || c.Forecast.LookbackWindow > 604800 {
+		c.Forecast.LookbackWindow = 3600
+	}
+	if c.Forecast.MinSamples < 2 || c.Forecast.MinSamples > 1000 {
+		c.Forecast.MinSamples = 10
+	}
 
 	return nil
 }
+
+// normalize 토큰 버킷 설정 보정 (음수 속도는 기본값 사용, 버스트 미설정 시 속도 값 사용)
+//
+// Parameters:
+//   - def: 기본 설정
+func (r *RateYaml) normalize(def RateYaml) {
+	if r.Rate < 0 {
+		*r = def
+	}
+	if r.Rate > 0 && r.Burst < 1 {
+		r.Burst = int(math.Ceil(r.Rate))
+	}
+}
+
+// normalize 리스너 설정 보정 (미설정 항목은 서버 설정 값 사용)
+//
+// Parameters:
+//   - clientAuth: 서버 클라이언트 인증서 요구 방식
+//   - clientCAFile: 서버 클라이언트 CA 파일 경로
+func (l *ListenerYaml) normalize(clientAuth, clientCAFile string) {
+	if l.Name == "" {
+		l.Name = l.Address
+	}
+	l.TLS = strings.ToLower(l.TLS)
+	switch l.TLS {
+	case "auto", "on", "off":
+	default:
+		l.TLS = "auto"
+	}
+	if l.ClientAuth == "" {
+		l.ClientAuth = clientAuth
+	}
+	if l.ClientCAFile == "" {
+		l.ClientCAFile = clientCAFile
+	}
+	if len(l.Routes) == 0 {
+		l.Routes = []string{"all"}
+	}
+	if l.SocketMode == "" {
+		l.SocketMode = "0660"
+	}
+	if l.SocketRoles == nil {
+		l.SocketRoles = []string{"admin"}
+	}
+}
+
+// validBuckets 히스토그램 버킷 유효성 검사 (비어 있지 않고 0보다 큰 값이 오름차순)
+//
+// Parameters:
+//   - buckets: 히스토그램 버킷
+//
+// Returns:
+//   - bool: 유효(true), 무효(false)
+func validBuckets(buckets []float64) bool {
+	if len(buckets) == 0 {
+		return false
+	}
+	for i, b := range buckets {
+		if b <= 0 || (i > 0 && b <= buckets[i-1]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,188 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+/*
+Package anomaly 메트릭 이상 탐지 패키지
+*/
+package anomaly
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/meloncoffee/unisys/config"
+)
+
+// Key 이상 탐지 대상 메트릭 식별 구조체
+type Key struct {
+	Metric    string // 메트릭명
+	Interface string // 네트워크 인터페이스명 (네트워크 메트릭이 아닐 경우 빈 문자열)
+}
+
+// Score 메트릭별 이상 점수 정보 구조체
+type Score struct {
+	Key
+	Value     float64 // 마지막 샘플 값
+	Mean      float64 // 베이스라인 평균
+	StdDev    float64 // 베이스라인 표준편차
+	ZScore    float64 // 마지막 샘플의 z-score 절대값
+	Anomalous bool    // 이상 여부 (z-score > sensitivity)
+}
+
+// ewma 지수 가중 이동 평균/분산 구조체
+type ewma struct {
+	mean     float64
+	variance float64
+	count    int
+}
+
+// baseline 메트릭별 베이스라인 구조체
+type baseline struct {
+	overall ewma
+	// 시간대(hour-of-day)별 계절성 베이스라인
+	seasons [24]ewma
+	score   Score
+	// 마지막 Sweep 이후 샘플 관측 여부 및 연속 미관측 횟수
+	observed bool
+	missed   int
+}
+
+var (
+	// 전역 베이스라인 변수 선언
+	baselines = make(map[Key]*baseline)
+	baseMutex sync.RWMutex
+)
+
+// Observe 샘플을 베이스라인과 비교하여 이상 점수를 계산한 뒤 베이스라인 갱신
+//
+// Parameters:
+//   - key: 메트릭 식별 정보
+//   - value: 샘플 값
+//   - t: 샘플 수집 시간
+//
+// Returns:
+//   - Score: 계산된 이상 점수 정보
+func Observe(key Key, value float64, t time.Time) Score {
+	baseMutex.Lock()
+	defer baseMutex.Unlock()
+
+	b, exists := baselines[key]
+	if !exists {
+		b = &baseline{}
+		baselines[key] = b
+	}
+	b.observed = true
+
+	// 계절성 옵션에 따라 비교 대상 베이스라인 선택
+	e := &b.overall
+	if config.Conf.Anomaly.Seasonal {
+		e = &b.seasons[t.Hour()]
+	}
+
+	// 베이스라인 갱신 전에 z-score 계산
+	stdDev := math.Sqrt(e.variance)
+	zScore := 0.0
+	if e.count >= config.Conf.Anomaly.MinSamples && stdDev > 0 {
+		zScore = math.Abs(value-e.mean) / stdDev
+	}
+
+	b.score = Score{
+		Key:       key,
+		Value:     value,
+		Mean:      e.mean,
+		StdDev:    stdDev,
+		ZScore:    zScore,
+		Anomalous: zScore > config.Conf.Anomaly.Sensitivity,
+	}
+
+	// 베이스라인 갱신 (계절성 사용 시에도 전체 베이스라인은 함께 유지)
+	alpha := config.Conf.Anomaly.Alpha
+	b.overall.update(value, alpha)
+	if e != &b.overall {
+		e.update(value, alpha)
+	}
+
+	return b.score
+}
+
+// Sweep 연속으로 관측되지 않은 메트릭의 베이스라인 제거
+// 수집 주기마다 Observe 호출 후 한 번 호출하며, 사라진 네트워크 인터페이스 등의
+// 베이스라인이 계속 쌓이지 않도록 제거 (이상 점수 메트릭 레이블도 함께 사라짐)
+//
+// Parameters:
+//   - maxMissed: 제거 전 허용되는 연속 미관측 수집 주기 수
+func Sweep(maxMissed int) {
+	baseMutex.Lock()
+	defer baseMutex.Unlock()
+
+	for key, b := range baselines {
+		if b.observed {
+			b.observed = false
+			b.missed = 0
+			continue
+		}
+		b.missed++
+		if b.missed >= maxMissed {
+			delete(baselines, key)
+		}
+	}
+}
+
+// GetScores 전체 메트릭의 최신 이상 점수 목록 반환
+//
+// Returns:
+//   - []Score: 메트릭명, 인터페이스명 순으로 정렬된 이상 점수 목록
+func GetScores() []Score {
+	baseMutex.RLock()
+	defer baseMutex.RUnlock()
+
+	scores := make([]Score, 0, len(baselines))
+	for _, b := range baselines {
+		scores = append(scores, b.score)
+	}
+
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].Metric != scores[j].Metric {
+			return scores[i].Metric < scores[j].Metric
+		}
+		return scores[i].Interface < scores[j].Interface
+	})
+
+	return scores
+}
+
+// update 샘플 값으로 지수 가중 이동 평균 및 분산 갱신
+//
+// Parameters:
+//   - value: 샘플 값
+//   - alpha: 평활 계수
+func (e *ewma) update(value, alpha float64) {
+	// 첫 샘플은 평균 값으로 사용
+	if e.count == 0 {
+		e.mean = value
+		e.variance = 0
+		e.count = 1
+		return
+	}
+
+	diff := value - e.mean
+	incr := alpha * diff
+	e.mean += incr
+	e.variance = (1 - alpha) * (e.variance + diff*incr)
+	e.count++
+}
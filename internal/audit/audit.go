@@ -0,0 +1,398 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+/*
+Package audit 해시 체인 기반 감사 로그 패키지
+*/
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/meloncoffee/unisys/config"
+)
+
+// 기록 주체 구분
+const (
+	SourceAPI = "api" // REST API 호출
+	SourceCLI = "cli" // CLI 명령
+)
+
+// 작업 결과
+const (
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+)
+
+// genesisHash 첫 번째 항목의 이전 해시 값
+var genesisHash = strings.Repeat("0", 64)
+
+// maxLineSize 감사 로그 한 줄 최대 크기
+const maxLineSize = 1 << 20
+
+// Entry 감사 로그 항목 구조체
+type Entry struct {
+	Seq        uint64            `json:"seq"`                  // 일련번호 (1부터 시작)
+	Time       time.Time         `json:"time"`                 // 작업 시작 시간
+	Source     string            `json:"source"`               // 기록 주체 (api, cli)
+	Identity   string            `json:"identity"`             // 작업 주체 이름
+	AuthMethod string            `json:"authMethod"`           // 인증 방식 (token, cert, password, oidc, os)
+	ClientIP   string            `json:"clientIP,omitempty"`   // 요청 IP (API)
+	RequestID  string            `json:"requestID,omitempty"`  // 요청 ID (API)
+	Action     string            `json:"action"`               // 작업 (예: POST /api/v1/login, token create)
+	Params     map[string]string `json:"params,omitempty"`     // 작업 파라미터 (비밀 값은 마스킹)
+	Result     string            `json:"result"`               // 결과 (success, failure)
+	Status     int               `json:"status,omitempty"`     // HTTP 상태 코드 (API)
+	Error      string            `json:"error,omitempty"`      // 실패 사유
+	DurationMs float64           `json:"durationMs"`           // 처리 시간(ms)
+	Suppressed uint64            `json:"suppressed,omitempty"` // 이전 기록 이후 생략된 인증 거부 요청 수 (API)
+	Prev       string            `json:"prev"`                 // 이전 항목 해시
+	Hash       string            `json:"hash"`                 // 현재 항목 해시 (HMAC-SHA256)
+}
+
+// head 마지막 항목 정보 (로그 끝부분 삭제 탐지용)
+type head struct {
+	Seq  uint64 `json:"seq"`
+	Hash string `json:"hash"`
+	Mac  string `json:"mac"` // Seq, Hash의 HMAC-SHA256 (head 파일 위조 방지)
+}
+
+// VerifyResult 감사 로그 검증 결과 구조체
+type VerifyResult struct {
+	Entries  int    // 검증된 항목 수
+	LastSeq  uint64 // 마지막 일련번호
+	LastHash string // 마지막 해시
+}
+
+// recordMutex 같은 프로세스 내 동시 기록 방지 (프로세스 간에는 flock 사용)
+var recordMutex sync.Mutex
+
+// headPath 마지막 항목 정보 파일 경로
+//
+// Parameters:
+//   - logPath: 감사 로그 파일 경로
+//
+// Returns:
+//   - string: head 파일 경로
+func headPath(logPath string) string {
+	return logPath + ".head"
+}
+
+// loadKey HMAC 키 로드 (create가 true이고 키 파일이 없으면 생성)
+//
+// Parameters:
+//   - keyPath: 키 파일 경로
+//   - create: 키 파일 생성 여부
+//
+// Returns:
+//   - []byte: HMAC 키
+//   - error: 성공(nil), 실패(error)
+func loadKey(keyPath string, create bool) ([]byte, error) {
+	data, err := os.ReadFile(keyPath)
+	if err == nil {
+		key, err := hex.DecodeString(strings.TrimSpace(string(data)))
+		if err != nil || len(key) < 32 {
+			return nil, fmt.Errorf("invalid audit key file (%s)", keyPath)
+		}
+		return key, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) || !create {
+		return nil, fmt.Errorf("failed to read audit key: %v", err)
+	}
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate audit key: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(keyPath), 0700); err != nil {
+		return nil, fmt.Errorf("failed to create audit key directory: %v", err)
+	}
+	// 다른 프로세스가 먼저 생성했으면 그 키를 사용
+	f, err := os.OpenFile(keyPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if errors.Is(err, os.ErrExist) {
+		return loadKey(keyPath, false)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create audit key: %v", err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(hex.EncodeToString(key) + "\n"); err != nil {
+		return nil, fmt.Errorf("failed to write audit key: %v", err)
+	}
+	return key, nil
+}
+
+// computeHash 항목 해시 계산 (Hash 필드를 비운 JSON의 HMAC-SHA256)
+//
+// Parameters:
+//   - key: HMAC 키
+//   - e: 감사 로그 항목
+//
+// Returns:
+//   - string: 해시 (hex)
+//   - error: 성공(nil), 실패(error)
+func computeHash(key []byte, e Entry) (string, error) {
+	e.Hash = ""
+	data, err := json.Marshal(e)
+	if err != nil {
+		return "", err
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// computeHeadMac head 파일 HMAC 계산
+// 항목 해시와 구분되도록 "head" 접두어를 붙여 계산
+//
+// Parameters:
+//   - key: HMAC 키
+//   - h: 마지막 항목 정보
+//
+// Returns:
+//   - string: HMAC (hex)
+func computeHeadMac(key []byte, h head) string {
+	mac := hmac.New(sha256.New, key)
+	fmt.Fprintf(mac, "head:%d:%s", h.Seq, h.Hash)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// lastEntry 감사 로그 파일의 마지막 항목 조회
+//
+// Parameters:
+//   - f: 감사 로그 파일
+//
+// Returns:
+//   - *Entry: 마지막 항목 (파일이 비어 있으면 nil)
+//   - error: 성공(nil), 실패(error)
+func lastEntry(f *os.File) (*Entry, error) {
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	size := info.Size()
+	if size == 0 {
+		return nil, nil
+	}
+
+	// 파일 끝에서부터 마지막 줄의 시작 위치 탐색
+	readLen := int64(maxLineSize)
+	if size < readLen {
+		readLen = size
+	}
+	buf := make([]byte, readLen)
+	if _, err := f.ReadAt(buf, size-readLen); err != nil && err != io.EOF {
+		return nil, err
+	}
+	buf = bytes.TrimRight(buf, "\n")
+	if idx := bytes.LastIndexByte(buf, '\n'); idx >= 0 {
+		buf = buf[idx+1:]
+	} else if readLen < size {
+		return nil, fmt.Errorf("last audit entry exceeds %d bytes", maxLineSize)
+	}
+
+	var e Entry
+	if err := json.Unmarshal(buf, &e); err != nil {
+		return nil, fmt.Errorf("last audit entry is corrupted, run 'unisys audit verify': %v", err)
+	}
+	return &e, nil
+}
+
+// writeHead 마지막 항목 정보 파일 갱신 (HMAC 설정 후 임시 파일 작성 및 교체)
+//
+// Parameters:
+//   - path: head 파일 경로
+//   - key: HMAC 키
+//   - h: 마지막 항목 정보
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func writeHead(path string, key []byte, h head) error {
+	h.Mac = computeHeadMac(key, h)
+	data, err := json.Marshal(h)
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Record 감사 로그 항목 기록
+// 이전 항목 해시를 연결하여 기록하며, CLI와 서버가 동시에 기록할 수 있도록 파일 잠금 사용
+//
+// Parameters:
+//   - e: 감사 로그 항목 (Seq, Prev, Hash는 자동 설정)
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func Record(e Entry) error {
+	conf := config.Conf.Audit
+	if !conf.Enabled {
+		return nil
+	}
+
+	recordMutex.Lock()
+	defer recordMutex.Unlock()
+
+	key, err := loadKey(conf.KeyFile, true)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(conf.File), 0700); err != nil {
+		return fmt.Errorf("failed to create audit log directory: %v", err)
+	}
+	f, err := os.OpenFile(conf.File, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log: %v", err)
+	}
+	defer f.Close()
+
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX); err != nil {
+		return fmt.Errorf("failed to lock audit log: %v", err)
+	}
+	defer syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+
+	last, err := lastEntry(f)
+	if err != nil {
+		return err
+	}
+	e.Seq, e.Prev = 1, genesisHash
+	if last != nil {
+		e.Seq, e.Prev = last.Seq+1, last.Hash
+	}
+	if e.Hash, err = computeHash(key, e); err != nil {
+		return fmt.Errorf("failed to hash audit entry: %v", err)
+	}
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit entry: %v", err)
+	}
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit entry: %v", err)
+	}
+	if err := f.Sync(); err != nil {
+		return fmt.Errorf("failed to sync audit log: %v", err)
+	}
+
+	if err := writeHead(headPath(conf.File), key, head{Seq: e.Seq, Hash: e.Hash}); err != nil {
+		return fmt.Errorf("failed to update audit head: %v", err)
+	}
+	return nil
+}
+
+// Verify 감사 로그 무결성 검증
+// 항목별 해시, 일련번호 연속성, 이전 해시 연결을 확인하고
+// HMAC으로 서명된 head 파일과 비교하여 끝부분 삭제(truncation)를 탐지
+// (이전에 복사해 둔 head 파일로 되돌린 뒤 그 지점까지 삭제한 경우는 탐지 불가)
+//
+// Parameters:
+//   - logPath: 감사 로그 파일 경로
+//   - keyPath: HMAC 키 파일 경로
+//
+// Returns:
+//   - VerifyResult: 검증 결과 (실패 시 실패 직전까지의 결과)
+//   - error: 무결(nil), 변조 또는 실패(error)
+func Verify(logPath, keyPath string) (VerifyResult, error) {
+	result := VerifyResult{LastHash: genesisHash}
+
+	key, err := loadKey(keyPath, false)
+	if err != nil {
+		return result, err
+	}
+
+	f, err := os.Open(logPath)
+	if err != nil {
+		return result, fmt.Errorf("failed to open audit log: %v", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), maxLineSize)
+	line := 0
+	for scanner.Scan() {
+		line++
+		var e Entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return result, fmt.Errorf("line %d: malformed entry: %v", line, err)
+		}
+		if e.Seq != result.LastSeq+1 {
+			return result, fmt.Errorf("line %d: expected seq %d, found %d (entries removed or reordered)",
+				line, result.LastSeq+1, e.Seq)
+		}
+		if e.Prev != result.LastHash {
+			return result, fmt.Errorf("line %d (seq %d): previous hash does not match", line, e.Seq)
+		}
+		hash, err := computeHash(key, e)
+		if err != nil {
+			return result, fmt.Errorf("line %d: %v", line, err)
+		}
+		if !hmac.Equal([]byte(hash), []byte(e.Hash)) {
+			return result, fmt.Errorf("line %d (seq %d): entry hash mismatch (entry modified)", line, e.Seq)
+		}
+
+		result.Entries++
+		result.LastSeq = e.Seq
+		result.LastHash = e.Hash
+	}
+	if err := scanner.Err(); err != nil {
+		return result, fmt.Errorf("failed to read audit log: %v", err)
+	}
+
+	// 끝부분 삭제 여부 확인
+	data, err := os.ReadFile(headPath(logPath))
+	if errors.Is(err, os.ErrNotExist) {
+		if result.Entries == 0 {
+			return result, nil
+		}
+		return result, fmt.Errorf("head file %s is missing", headPath(logPath))
+	}
+	if err != nil {
+		return result, fmt.Errorf("failed to read head file: %v", err)
+	}
+	var h head
+	if err := json.Unmarshal(data, &h); err != nil {
+		return result, fmt.Errorf("malformed head file: %v", err)
+	}
+	if !hmac.Equal([]byte(computeHeadMac(key, h)), []byte(h.Mac)) {
+		return result, fmt.Errorf("head file signature mismatch (head file forged or written by an older version)")
+	}
+	if h.Seq != result.LastSeq || h.Hash != result.LastHash {
+		return result, fmt.Errorf("log ends at seq %d but head records seq %d (log truncated or head stale)",
+			result.LastSeq, h.Seq)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,184 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/meloncoffee/unisys/config"
+)
+
+// writeTestLog 임시 디렉터리에 감사 로그 항목 n개 기록
+//
+// Returns:
+//   - string: 감사 로그 파일 경로
+//   - string: 키 파일 경로
+func writeTestLog(t *testing.T, n int) (string, string) {
+	t.Helper()
+	saved := config.Conf.Audit
+	t.Cleanup(func() { config.Conf.Audit = saved })
+
+	dir := t.TempDir()
+	config.Conf.Audit.Enabled = true
+	config.Conf.Audit.File = filepath.Join(dir, "audit.log")
+	config.Conf.Audit.KeyFile = filepath.Join(dir, "audit.key")
+	for i := 0; i < n; i++ {
+		entry := Entry{Source: SourceCLI, Identity: "tester", Action: "token create", Result: ResultSuccess,
+			Params: map[string]string{"name": "t" + string(rune('a'+i))}}
+		if err := Record(entry); err != nil {
+			t.Fatalf("Record: %v", err)
+		}
+	}
+	return config.Conf.Audit.File, config.Conf.Audit.KeyFile
+}
+
+// readLines 감사 로그 파일을 줄 단위로 읽기
+func readLines(t *testing.T, path string) [][]byte {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bytes.SplitAfter(bytes.TrimRight(data, "\n"), []byte("\n"))
+}
+
+// writeLines 줄 목록으로 감사 로그 파일 덮어쓰기
+func writeLines(t *testing.T, path string, lines [][]byte) {
+	t.Helper()
+	var buf bytes.Buffer
+	for _, line := range lines {
+		buf.Write(bytes.TrimRight(line, "\n"))
+		buf.WriteByte('\n')
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	tests := []struct {
+		name    string
+		tamper  func(t *testing.T, logPath, keyPath string)
+		wantErr string
+	}{
+		{
+			name:   "intact",
+			tamper: func(*testing.T, string, string) {},
+		},
+		{
+			name: "modified entry",
+			tamper: func(t *testing.T, logPath, _ string) {
+				lines := readLines(t, logPath)
+				lines[1] = bytes.Replace(lines[1], []byte(`"identity":"tester"`), []byte(`"identity":"mallory"`), 1)
+				writeLines(t, logPath, lines)
+			},
+			wantErr: "entry hash mismatch",
+		},
+		{
+			name: "reordered entries",
+			tamper: func(t *testing.T, logPath, _ string) {
+				lines := readLines(t, logPath)
+				lines[1], lines[2] = lines[2], lines[1]
+				writeLines(t, logPath, lines)
+			},
+			wantErr: "expected seq 2, found 3",
+		},
+		{
+			name: "removed entry",
+			tamper: func(t *testing.T, logPath, _ string) {
+				lines := readLines(t, logPath)
+				writeLines(t, logPath, append(lines[:1], lines[2:]...))
+			},
+			wantErr: "expected seq 2, found 3",
+		},
+		{
+			name: "truncated log",
+			tamper: func(t *testing.T, logPath, _ string) {
+				lines := readLines(t, logPath)
+				writeLines(t, logPath, lines[:2])
+			},
+			wantErr: "log ends at seq 2 but head records seq 3",
+		},
+		{
+			name: "truncated log with forged head",
+			tamper: func(t *testing.T, logPath, _ string) {
+				lines := readLines(t, logPath)
+				writeLines(t, logPath, lines[:2])
+				last, err := lastEntryOf(lines[1])
+				if err != nil {
+					t.Fatal(err)
+				}
+				forged := `{"seq":2,"hash":"` + last.Hash + `","mac":"` + strings.Repeat("0", 64) + `"}` + "\n"
+				if err := os.WriteFile(headPath(logPath), []byte(forged), 0600); err != nil {
+					t.Fatal(err)
+				}
+			},
+			wantErr: "head file signature mismatch",
+		},
+		{
+			name: "missing head",
+			tamper: func(t *testing.T, logPath, _ string) {
+				if err := os.Remove(headPath(logPath)); err != nil {
+					t.Fatal(err)
+				}
+			},
+			wantErr: "is missing",
+		},
+		{
+			name: "different key",
+			tamper: func(t *testing.T, _, keyPath string) {
+				if err := os.WriteFile(keyPath, []byte(strings.Repeat("ab", 32)+"\n"), 0600); err != nil {
+					t.Fatal(err)
+				}
+			},
+			wantErr: "entry hash mismatch",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logPath, keyPath := writeTestLog(t, 3)
+			tt.tamper(t, logPath, keyPath)
+
+			result, err := Verify(logPath, keyPath)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Verify() error = %v", err)
+				}
+				if result.Entries != 3 || result.LastSeq != 3 {
+					t.Errorf("Verify() = %+v, want 3 entries", result)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Verify() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// lastEntryOf 감사 로그 한 줄을 항목으로 디코딩
+func lastEntryOf(line []byte) (Entry, error) {
+	var e Entry
+	err := json.Unmarshal(bytes.TrimRight(line, "\n"), &e)
+	return e, err
+}
@@ -0,0 +1,170 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+/*
+Package auth 인증 및 권한 패키지
+*/
+package auth
+
+import (
+	"fmt"
+	"sort"
+)
+
+// 인증 방식
+const (
+	MethodToken    = "token"
+	MethodCert     = "cert"
+	MethodPassword = "password"
+	MethodOIDC     = "oidc"
+	MethodUnix     = "unix"
+)
+
+// 권한 범위 (scope)
+const (
+	ScopeMetricsRead   = "metrics:read"   // 메트릭, 요청 통계 조회
+	ScopeResourcesRead = "resources:read" // 리소스 정보 조회 및 스트리밍
+	ScopeOperate       = "operate"        // 관리 작업 실행
+	ScopeAdmin         = "admin"          // 모든 권한
+)
+
+// KnownScopes 사용 가능한 권한 범위 목록
+var KnownScopes = []string{ScopeMetricsRead, ScopeResourcesRead, ScopeOperate, ScopeAdmin}
+
+// Identity 인증된 요청 주체 정보 구조체
+type Identity struct {
+	Name   string   // 주체 이름 (토큰명, 인증서 CN 등)
+	Method string   // 인증 방식
+	Roles  []string // 부여된 역할
+	Scopes []string // 직접 부여된 권한 범위 (역할의 권한은 LookupRole로 확인)
+}
+
+// NewIdentity 인증 주체 생성
+//
+// Parameters:
+//   - name: 주체 이름
+//   - method: 인증 방식
+//   - roles: 역할 목록
+//   - scopes: 직접 부여된 권한 범위 목록
+//
+// Returns:
+//   - *Identity: 인증 주체 정보
+func NewIdentity(name, method string, roles, scopes []string) *Identity {
+	return &Identity{
+		Name:   name,
+		Method: method,
+		Roles:  uniqueSorted(roles),
+		Scopes: uniqueSorted(scopes),
+	}
+}
+
+// uniqueSorted 중복을 제거하고 정렬한 목록 생성 (비어 있으면 nil)
+//
+// Parameters:
+//   - values: 문자열 목록
+//
+// Returns:
+//   - []string: 중복이 제거된 정렬 목록
+func uniqueSorted(values []string) []string {
+	set := make(map[string]struct{}, len(values))
+	var result []string
+	for _, value := range values {
+		if _, dup := set[value]; dup {
+			continue
+		}
+		set[value] = struct{}{}
+		result = append(result, value)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// HasScope 직접 부여되었거나 역할을 통해 부여된 권한 범위 보유 여부 확인
+// (admin은 모든 권한 포함)
+//
+// Parameters:
+//   - scope: 요구되는 권한 범위
+//
+// Returns:
+//   - bool: 보유(true), 미보유(false)
+func (i *Identity) HasScope(scope string) bool {
+	if i == nil {
+		return false
+	}
+
+	if scopeGranted(i.Scopes, scope) {
+		return true
+	}
+	for _, name := range i.Roles {
+		if role, ok := LookupRole(name); ok && scopeGranted(role.Scopes, scope) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// scopeGranted 권한 범위 목록이 요구 권한 범위를 포함하는지 확인
+//
+// Parameters:
+//   - scopes: 부여된 권한 범위 목록
+//   - scope: 요구되는 권한 범위
+//
+// Returns:
+//   - bool: 포함(true), 미포함(false)
+func scopeGranted(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if s == scope || s == ScopeAdmin {
+			return true
+		}
+	}
+	return false
+}
+
+// String 로그 출력용 문자열 반환
+//
+// Returns:
+//   - string: 인증 방식:주체 이름
+func (i *Identity) String() string {
+	if i == nil {
+		return "anonymous"
+	}
+	return i.Method + ":" + i.Name
+}
+
+// ValidateScopes 권한 범위 목록 유효성 검사
+//
+// Parameters:
+//   - scopes: 권한 범위 목록
+//
+// Returns:
+//   - error: 성공(nil), 알 수 없는 권한 범위 포함(error)
+func ValidateScopes(scopes []string) error {
+	for _, scope := range scopes {
+		known := false
+		for _, k := range KnownScopes {
+			if scope == k {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return fmt.Errorf("unknown scope: %s", scope)
+		}
+	}
+
+	return nil
+}
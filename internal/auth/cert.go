@@ -0,0 +1,101 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package auth
+
+import (
+	"crypto/x509"
+	"path"
+	"strings"
+)
+
+// certNames 클라이언트 인증서의 매칭 대상 이름 목록 생성
+// (CN=<common name>, DNS:<name>, IP:<addr>, EMAIL:<addr>, URI:<uri>, 전체 subject)
+//
+// Parameters:
+//   - cert: 클라이언트 인증서
+//
+// Returns:
+//   - []string: 매칭 대상 이름 목록
+func certNames(cert *x509.Certificate) []string {
+	names := []string{cert.Subject.String()}
+	if cert.Subject.CommonName != "" {
+		names = append(names, "CN="+cert.Subject.CommonName)
+	}
+	for _, dns := range cert.DNSNames {
+		names = append(names, "DNS:"+dns)
+	}
+	for _, ip := range cert.IPAddresses {
+		names = append(names, "IP:"+ip.String())
+	}
+	for _, email := range cert.EmailAddresses {
+		names = append(names, "EMAIL:"+email)
+	}
+	for _, uri := range cert.URIs {
+		names = append(names, "URI:"+uri.String())
+	}
+	return names
+}
+
+// MatchCert 클라이언트 인증서가 패턴과 일치하는지 확인
+// 패턴은 certNames 형식이며 path.Match 와일드카드(*, ?)를 지원
+//
+// Parameters:
+//   - cert: 클라이언트 인증서
+//   - pattern: 매칭 패턴 (예: CN=prometheus, DNS:*.example.com)
+//
+// Returns:
+//   - bool: 일치(true), 불일치(false)
+func MatchCert(cert *x509.Certificate, pattern string) bool {
+	for _, name := range certNames(cert) {
+		if ok, err := path.Match(pattern, name); err == nil && ok {
+			return true
+		}
+		if strings.EqualFold(pattern, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// CertIdentity 검증된 클라이언트 인증서로부터 인증 주체 생성
+//
+// Parameters:
+//   - cert: 검증된 클라이언트 인증서
+//   - roleMappings: 인증서 패턴별 역할 목록
+//
+// Returns:
+//   - *Identity: 인증 주체 정보
+func CertIdentity(cert *x509.Certificate, roleMappings map[string][]string) *Identity {
+	// 주체 이름은 CN, 없으면 첫 번째 SAN 사용
+	name := cert.Subject.CommonName
+	if name == "" {
+		if names := certNames(cert); len(names) > 1 {
+			name = names[1]
+		} else {
+			name = cert.Subject.String()
+		}
+	}
+
+	var roles []string
+	for pattern, mapped := range roleMappings {
+		if MatchCert(cert, pattern) {
+			roles = append(roles, mapped...)
+		}
+	}
+
+	return NewIdentity(name, MethodCert, roles, nil)
+}
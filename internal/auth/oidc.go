@@ -0,0 +1,386 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/meloncoffee/unisys/config"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// oidcHTTPTimeout 발급자(discovery, JWKS, 토큰 엔드포인트) 요청 제한 시간
+	oidcHTTPTimeout = 10 * time.Second
+	// oidcRetryInterval discovery 실패 후 재시도 최소 간격
+	oidcRetryInterval = 30 * time.Second
+	// oidcPendingTTL 인가 요청 후 콜백까지 허용 시간
+	oidcPendingTTL = 10 * time.Minute
+	// maxPendingLogins 동시에 진행 가능한 최대 인가 요청 수
+	maxPendingLogins = 10000
+)
+
+// oidcPending 진행 중인 인가 요청 정보
+type oidcPending struct {
+	nonce     string    // ID 토큰 nonce
+	verifier  string    // PKCE code verifier
+	expiresAt time.Time // 만료 시간
+}
+
+// OIDCProvider OpenID Connect 발급자 연동 구조체
+// discovery 결과와 JWKS는 최초 사용 시 가져와 캐시하며,
+// JWKS는 알 수 없는 키 ID의 토큰을 받으면 다시 조회됨
+type OIDCProvider struct {
+	mu            sync.Mutex
+	client        *http.Client
+	provider      *oidc.Provider
+	idVerifier    *oidc.IDTokenVerifier
+	tokenVerifier *oidc.IDTokenVerifier
+	oauth2Conf    oauth2.Config
+	lastAttempt   time.Time
+	lastErr       error
+	pending       map[string]oidcPending
+}
+
+// NewOIDCProvider 설정 파일의 OIDC 설정으로 발급자 연동 생성
+// 발급자에 대한 네트워크 요청은 Discover 또는 최초 사용 시 수행
+//
+// Returns:
+//   - *OIDCProvider
+//   - error: 성공(nil), 실패(error)
+func NewOIDCProvider() (*OIDCProvider, error) {
+	conf := config.Conf.Auth.OIDC
+	if conf.Issuer == "" || conf.ClientID == "" || conf.RedirectURL == "" {
+		return nil, fmt.Errorf("issuer, clientID and redirectURL are required")
+	}
+	if err := ValidateRoles(conf.DefaultRoles); err != nil {
+		return nil, fmt.Errorf("defaultRoles: %v", err)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if conf.CAFile != "" {
+		pem, err := os.ReadFile(conf.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read caFile: %v", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in caFile (%s)", conf.CAFile)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+
+	// openid scope는 항상 포함
+	scopes := []string{oidc.ScopeOpenID}
+	for _, scope := range conf.Scopes {
+		if scope != oidc.ScopeOpenID {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	return &OIDCProvider{
+		client: &http.Client{Transport: transport, Timeout: oidcHTTPTimeout},
+		oauth2Conf: oauth2.Config{
+			ClientID:     conf.ClientID,
+			ClientSecret: conf.ClientSecret,
+			RedirectURL:  conf.RedirectURL,
+			Scopes:       scopes,
+		},
+		pending: make(map[string]oidcPending),
+	}, nil
+}
+
+// clientContext 발급자 요청에 사용할 HTTP 클라이언트를 담은 컨텍스트 생성
+//
+// Parameters:
+//   - ctx: 상위 컨텍스트
+//
+// Returns:
+//   - context.Context: HTTP 클라이언트가 설정된 컨텍스트
+func (p *OIDCProvider) clientContext(ctx context.Context) context.Context {
+	return oidc.ClientContext(ctx, p.client)
+}
+
+// Discover 발급자 discovery 문서 조회 (성공 시 캐시, 실패 시 일정 시간 후 재시도)
+//
+// Parameters:
+//   - ctx: 요청 컨텍스트
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func (p *OIDCProvider) Discover(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.provider != nil {
+		return nil
+	}
+	if p.lastErr != nil && time.Since(p.lastAttempt) < oidcRetryInterval {
+		return p.lastErr
+	}
+	p.lastAttempt = time.Now()
+
+	conf := config.Conf.Auth.OIDC
+	// JWKS 조회는 요청 컨텍스트가 끝난 뒤에도 사용되므로 클라이언트만 전달
+	provider, err := oidc.NewProvider(p.clientContext(context.Background()), conf.Issuer)
+	if err != nil {
+		p.lastErr = fmt.Errorf("oidc discovery failed (%s): %v", conf.Issuer, err)
+		return p.lastErr
+	}
+
+	p.provider = provider
+	p.lastErr = nil
+	p.oauth2Conf.Endpoint = provider.Endpoint()
+	p.idVerifier = provider.Verifier(&oidc.Config{ClientID: conf.ClientID})
+	// 베어러 토큰의 audience는 설정된 목록으로 직접 확인
+	p.tokenVerifier = provider.Verifier(&oidc.Config{SkipClientIDCheck: true})
+
+	return nil
+}
+
+// AuthCodeURL 인가 요청 URL 생성 (state, nonce, PKCE 적용)
+//
+// Parameters:
+//   - ctx: 요청 컨텍스트
+//
+// Returns:
+//   - string: 발급자 인가 엔드포인트 URL
+//   - string: state 값 (콜백 시 확인)
+//   - error: 성공(nil), 실패(error)
+func (p *OIDCProvider) AuthCodeURL(ctx context.Context) (string, string, error) {
+	if err := p.Discover(ctx); err != nil {
+		return "", "", err
+	}
+
+	state, err := randomToken()
+	if err != nil {
+		return "", "", err
+	}
+	nonce, err := randomToken()
+	if err != nil {
+		return "", "", err
+	}
+	verifier := oauth2.GenerateVerifier()
+
+	p.mu.Lock()
+	now := time.Now()
+	for s, pending := range p.pending {
+		if now.After(pending.expiresAt) {
+			delete(p.pending, s)
+		}
+	}
+	if len(p.pending) >= maxPendingLogins {
+		p.mu.Unlock()
+		return "", "", fmt.Errorf("too many pending oidc logins")
+	}
+	p.pending[state] = oidcPending{nonce: nonce, verifier: verifier, expiresAt: now.Add(oidcPendingTTL)}
+	p.mu.Unlock()
+
+	url := p.oauth2Conf.AuthCodeURL(state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(verifier))
+	return url, state, nil
+}
+
+// Exchange 인가 코드를 토큰으로 교환하고 ID 토큰을 검증하여 인증 주체 생성
+//
+// Parameters:
+//   - ctx: 요청 컨텍스트
+//   - state: 콜백으로 전달된 state 값
+//   - code: 인가 코드
+//
+// Returns:
+//   - *Identity: 인증 주체 정보
+//   - error: 성공(nil), 실패(error)
+func (p *OIDCProvider) Exchange(ctx context.Context, state, code string) (*Identity, error) {
+	p.mu.Lock()
+	pending, exists := p.pending[state]
+	delete(p.pending, state)
+	p.mu.Unlock()
+	if !exists || time.Now().After(pending.expiresAt) {
+		return nil, fmt.Errorf("unknown or expired login state")
+	}
+
+	if err := p.Discover(ctx); err != nil {
+		return nil, err
+	}
+
+	ctx = p.clientContext(ctx)
+	token, err := p.oauth2Conf.Exchange(ctx, code, oauth2.VerifierOption(pending.verifier))
+	if err != nil {
+		return nil, fmt.Errorf("code exchange failed: %v", err)
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, fmt.Errorf("token response has no id_token")
+	}
+
+	idToken, err := p.idVerifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("invalid id token: %v", err)
+	}
+	if idToken.Nonce != pending.nonce {
+		return nil, fmt.Errorf("id token nonce mismatch")
+	}
+
+	return p.identityFromToken(idToken)
+}
+
+// VerifyBearer 발급자가 서명한 JWT 베어러 토큰 검증 및 인증 주체 생성
+//
+// Parameters:
+//   - ctx: 요청 컨텍스트
+//   - rawToken: JWT 문자열
+//
+// Returns:
+//   - *Identity: 인증 주체 정보
+//   - error: 성공(nil), 실패(error)
+func (p *OIDCProvider) VerifyBearer(ctx context.Context, rawToken string) (*Identity, error) {
+	if err := p.Discover(ctx); err != nil {
+		return nil, err
+	}
+
+	token, err := p.tokenVerifier.Verify(p.clientContext(ctx), rawToken)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bearer jwt: %v", err)
+	}
+
+	conf := config.Conf.Auth.OIDC
+	audiences := conf.Audiences
+	if len(audiences) == 0 {
+		audiences = []string{conf.ClientID}
+	}
+	if !audienceAllowed(token.Audience, audiences) {
+		return nil, fmt.Errorf("bearer jwt audience %v is not accepted", token.Audience)
+	}
+
+	return p.identityFromToken(token)
+}
+
+// identityFromToken 검증된 토큰의 클레임으로 인증 주체 생성
+// 그룹 클레임은 groupRoles 설정으로 역할에 매핑되며, 매핑되지 않은 그룹은 무시
+//
+// Parameters:
+//   - token: 검증된 토큰
+//
+// Returns:
+//   - *Identity: 인증 주체 정보
+//   - error: 성공(nil), 실패(error)
+func (p *OIDCProvider) identityFromToken(token *oidc.IDToken) (*Identity, error) {
+	var claims map[string]interface{}
+	if err := token.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("failed to parse token claims: %v", err)
+	}
+
+	conf := config.Conf.Auth.OIDC
+	name, _ := lookupClaim(claims, conf.UsernameClaim).(string)
+	if name == "" {
+		name = token.Subject
+	}
+
+	roles := append([]string(nil), conf.DefaultRoles...)
+	for _, group := range claimStrings(lookupClaim(claims, conf.GroupsClaim)) {
+		roles = append(roles, conf.GroupRoles[group]...)
+	}
+
+	return NewIdentity(name, MethodOIDC, roles, nil), nil
+}
+
+// lookupClaim 점으로 구분된 경로의 클레임 값 조회 (예: realm_access.roles)
+//
+// Parameters:
+//   - claims: 토큰 클레임
+//   - name: 클레임 경로
+//
+// Returns:
+//   - interface{}: 클레임 값 (없으면 nil)
+func lookupClaim(claims map[string]interface{}, name string) interface{} {
+	// 점을 포함한 이름의 클레임이 그대로 존재하면 우선 사용
+	if v, ok := claims[name]; ok {
+		return v
+	}
+
+	var cur interface{} = claims
+	for _, key := range strings.Split(name, ".") {
+		m, ok := cur.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		cur = m[key]
+	}
+	return cur
+}
+
+// claimStrings 문자열 또는 문자열 배열 클레임을 문자열 목록으로 변환
+//
+// Parameters:
+//   - v: 클레임 값
+//
+// Returns:
+//   - []string: 문자열 목록
+func claimStrings(v interface{}) []string {
+	switch val := v.(type) {
+	case string:
+		return []string{val}
+	case []interface{}:
+		var values []string
+		for _, item := range val {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+// audienceAllowed 토큰 audience 중 허용된 값이 있는지 확인
+//
+// Parameters:
+//   - tokenAud: 토큰의 audience 목록
+//   - allowed: 허용 audience 목록
+//
+// Returns:
+//   - bool: 허용(true), 거부(false)
+func audienceAllowed(tokenAud, allowed []string) bool {
+	for _, aud := range tokenAud {
+		for _, a := range allowed {
+			if aud == a {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsJWT 토큰이 JWT(JWS compact) 형식인지 확인
+//
+// Parameters:
+//   - token: 토큰 문자열
+//
+// Returns:
+//   - bool: JWT 형식(true), 아님(false)
+func IsJWT(token string) bool {
+	return strings.Count(token, ".") == 2 && !strings.HasPrefix(token, tokenPrefix)
+}
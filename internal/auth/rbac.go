@@ -0,0 +1,213 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package auth
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/meloncoffee/unisys/config"
+)
+
+// 기본 역할
+const (
+	RoleViewer   = "viewer"   // 메트릭 및 리소스 조회
+	RoleOperator = "operator" // 조회 및 관리 작업 실행
+	RoleAdmin    = "admin"    // 모든 권한
+)
+
+// builtinRoles 기본 역할 정의
+var builtinRoles = map[string]config.RoleYaml{
+	RoleViewer: {
+		Scopes: []string{ScopeMetricsRead, ScopeResourcesRead},
+	},
+	RoleOperator: {
+		Scopes: []string{ScopeMetricsRead, ScopeResourcesRead, ScopeOperate},
+	},
+	RoleAdmin: {
+		Scopes: []string{ScopeAdmin},
+	},
+}
+
+// LookupRole 역할 정의 조회 (기본 역할 우선, 없으면 설정 파일의 사용자 정의 역할)
+//
+// Parameters:
+//   - name: 역할명
+//
+// Returns:
+//   - config.RoleYaml: 역할 정의
+//   - bool: 존재(true), 미존재(false)
+func LookupRole(name string) (config.RoleYaml, bool) {
+	if role, ok := builtinRoles[name]; ok {
+		return role, true
+	}
+	role, ok := config.Conf.RBAC.Roles[name]
+	return role, ok
+}
+
+// KnownRoles 사용 가능한 역할 목록
+//
+// Returns:
+//   - []string: 정렬된 역할명 목록
+func KnownRoles() []string {
+	var roles []string
+	for name := range builtinRoles {
+		roles = append(roles, name)
+	}
+	for name := range config.Conf.RBAC.Roles {
+		if _, ok := builtinRoles[name]; !ok {
+			roles = append(roles, name)
+		}
+	}
+	sort.Strings(roles)
+	return roles
+}
+
+// ValidateRoles 역할 목록 유효성 검사
+//
+// Parameters:
+//   - roles: 역할 목록
+//
+// Returns:
+//   - error: 성공(nil), 알 수 없는 역할 포함(error)
+func ValidateRoles(roles []string) error {
+	for _, role := range roles {
+		if _, ok := LookupRole(role); !ok {
+			return fmt.Errorf("unknown role: %s", role)
+		}
+	}
+	return nil
+}
+
+// ValidateRoleConfig 설정 파일의 사용자 정의 역할 및 역할 매핑 유효성 검사
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func ValidateRoleConfig() error {
+	for pattern, roles := range config.Conf.Server.ClientRoles {
+		if err := ValidateRoles(roles); err != nil {
+			return fmt.Errorf("clientRoles %s: %v", pattern, err)
+		}
+	}
+	for group, roles := range config.Conf.Auth.OIDC.GroupRoles {
+		if err := ValidateRoles(roles); err != nil {
+			return fmt.Errorf("oidc groupRoles %s: %v", group, err)
+		}
+	}
+
+	for name, role := range config.Conf.RBAC.Roles {
+		if _, ok := builtinRoles[name]; ok {
+			return fmt.Errorf("role %s overrides a built-in role", name)
+		}
+		if err := ValidateScopes(role.Scopes); err != nil {
+			return fmt.Errorf("role %s: %v", name, err)
+		}
+		for _, perm := range role.Permissions {
+			if len(perm.Paths) == 0 {
+				return fmt.Errorf("role %s: permission without paths", name)
+			}
+			for _, pattern := range perm.Paths {
+				if _, err := path.Match(strings.TrimSuffix(pattern, "/**"), "/"); err != nil {
+					return fmt.Errorf("role %s: invalid path pattern %q", name, pattern)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Allowed 요청에 대한 권한 확인
+// 라우트 권한 범위를 보유했거나, 역할의 경로/메서드 권한이 요청과 일치하면 허용
+//
+// Parameters:
+//   - scope: 라우트에 요구되는 권한 범위
+//   - method: HTTP 메서드
+//   - reqPath: 요청 경로
+//
+// Returns:
+//   - bool: 허용(true), 거부(false)
+func (i *Identity) Allowed(scope, method, reqPath string) bool {
+	if i == nil {
+		return false
+	}
+	if i.HasScope(scope) {
+		return true
+	}
+
+	for _, name := range i.Roles {
+		role, ok := LookupRole(name)
+		if !ok {
+			continue
+		}
+		for _, perm := range role.Permissions {
+			if permissionMatches(perm, method, reqPath) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// permissionMatches 권한의 메서드/경로 패턴이 요청과 일치하는지 확인
+//
+// Parameters:
+//   - perm: 권한 정의
+//   - method: HTTP 메서드
+//   - reqPath: 요청 경로
+//
+// Returns:
+//   - bool: 일치(true), 불일치(false)
+func permissionMatches(perm config.PermissionYaml, method, reqPath string) bool {
+	methodOK := len(perm.Methods) == 0
+	for _, m := range perm.Methods {
+		if m == "*" || strings.EqualFold(m, method) {
+			methodOK = true
+			break
+		}
+	}
+	if !methodOK {
+		return false
+	}
+
+	for _, pattern := range perm.Paths {
+		if MatchPath(pattern, reqPath) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MatchPath 경로 패턴 일치 여부 확인
+// 와일드카드(path.Match 문법)를 사용할 수 있으며 '/**' 접미어는 해당 경로와 모든 하위 경로를 의미
+//
+// Parameters:
+//   - pattern: 경로 패턴
+//   - reqPath: 요청 경로
+//
+// Returns:
+//   - bool: 일치(true), 불일치(false)
+func MatchPath(pattern, reqPath string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "/**"); ok {
+		return reqPath == prefix || strings.HasPrefix(reqPath, prefix+"/")
+	}
+	ok, err := path.Match(pattern, reqPath)
+	return err == nil && ok
+}
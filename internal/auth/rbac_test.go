@@ -0,0 +1,82 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package auth
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/meloncoffee/unisys/config"
+)
+
+func TestIdentityAllowed(t *testing.T) {
+	saved := config.Conf.RBAC.Roles
+	t.Cleanup(func() { config.Conf.RBAC.Roles = saved })
+	config.Conf.RBAC.Roles = map[string]config.RoleYaml{
+		"scraper": {
+			Scopes: []string{ScopeMetricsRead},
+			Permissions: []config.PermissionYaml{
+				{Methods: []string{"GET"}, Paths: []string{"/api/v1/resources/cpu"}},
+			},
+		},
+		"sysadmin": {
+			Permissions: []config.PermissionYaml{
+				{Paths: []string{"/api/v1/sys/**"}},
+			},
+		},
+	}
+
+	viewer := NewIdentity("v", MethodToken, []string{RoleViewer}, nil)
+	operator := NewIdentity("o", MethodToken, []string{RoleOperator}, nil)
+	admin := NewIdentity("a", MethodToken, []string{RoleAdmin}, nil)
+	scraper := NewIdentity("s", MethodToken, []string{"scraper"}, nil)
+	sysadmin := NewIdentity("sa", MethodToken, []string{"sysadmin"}, nil)
+	scoped := NewIdentity("t", MethodToken, nil, []string{ScopeOperate})
+	unknown := NewIdentity("u", MethodToken, []string{"missing"}, nil)
+
+	tests := []struct {
+		name     string
+		identity *Identity
+		scope    string
+		method   string
+		path     string
+		want     bool
+	}{
+		{"nil identity", nil, ScopeMetricsRead, http.MethodGet, "/api/v1/metrics", false},
+		{"viewer reads metrics", viewer, ScopeMetricsRead, http.MethodGet, "/api/v1/metrics", true},
+		{"viewer cannot operate", viewer, ScopeOperate, http.MethodPost, "/api/v1/logout", false},
+		{"operator operates", operator, ScopeOperate, http.MethodPost, "/api/v1/logout", true},
+		{"admin has every scope", admin, ScopeOperate, http.MethodPost, "/api/v1/logout", true},
+		{"direct token scope", scoped, ScopeOperate, http.MethodPost, "/api/v1/logout", true},
+		{"direct scope does not grant others", scoped, ScopeMetricsRead, http.MethodGet, "/api/v1/metrics", false},
+		{"custom role scope", scraper, ScopeMetricsRead, http.MethodGet, "/api/v1/metrics", true},
+		{"custom role path permission", scraper, ScopeResourcesRead, http.MethodGet, "/api/v1/resources/cpu", true},
+		{"custom role method mismatch", scraper, ScopeResourcesRead, http.MethodPost, "/api/v1/resources/cpu", false},
+		{"custom role other path", scraper, ScopeResourcesRead, http.MethodGet, "/api/v1/resources/memory", false},
+		{"subtree permission", sysadmin, ScopeMetricsRead, http.MethodGet, "/api/v1/sys/stats", true},
+		{"subtree root", sysadmin, ScopeMetricsRead, http.MethodGet, "/api/v1/sys", true},
+		{"subtree prefix only", sysadmin, ScopeMetricsRead, http.MethodGet, "/api/v1/system", false},
+		{"unknown role", unknown, ScopeMetricsRead, http.MethodGet, "/api/v1/metrics", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.identity.Allowed(tt.scope, tt.method, tt.path); got != tt.want {
+				t.Errorf("Allowed(%q, %q, %q) = %v, want %v", tt.scope, tt.method, tt.path, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,275 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package auth
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Session 로그인 세션 정보 구조체
+type Session struct {
+	ID        string    // 세션 ID (쿠키 값)
+	CSRFToken string    // CSRF 토큰
+	Identity  *Identity // 인증 주체 정보
+	CreatedAt time.Time // 생성 시간
+	LastSeen  time.Time // 마지막 사용 시간
+	ExpiresAt time.Time // 절대 만료 시간
+}
+
+// SessionStore 메모리 기반 세션 저장소 구조체
+type SessionStore struct {
+	mu          sync.Mutex
+	idleTimeout time.Duration
+	maxAge      time.Duration
+	users       *UserStore
+	sessions    map[string]*Session
+}
+
+// NewSessionStore 세션 저장소 생성
+//
+// Parameters:
+//   - idleTimeout: 미사용 만료 시간
+//   - maxAge: 절대 만료 시간
+//   - users: 비밀번호 로그인 세션의 사용자 확인용 저장소 (nil이면 확인 안함)
+//
+// Returns:
+//   - *SessionStore
+func NewSessionStore(idleTimeout, maxAge time.Duration, users *UserStore) *SessionStore {
+	return &SessionStore{
+		idleTimeout: idleTimeout,
+		maxAge:      maxAge,
+		users:       users,
+		sessions:    make(map[string]*Session),
+	}
+}
+
+// randomToken 임의의 URL-safe 토큰 생성
+//
+// Returns:
+//   - string: 토큰
+//   - error: 성공(nil), 실패(error)
+func randomToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random token: %v", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// Create 세션 생성
+//
+// Parameters:
+//   - identity: 인증 주체 정보
+//
+// Returns:
+//   - Session: 생성된 세션 복사본
+//   - error: 성공(nil), 실패(error)
+func (ss *SessionStore) Create(identity *Identity) (Session, error) {
+	id, err := randomToken()
+	if err != nil {
+		return Session{}, err
+	}
+	csrf, err := randomToken()
+	if err != nil {
+		return Session{}, err
+	}
+
+	now := time.Now()
+	session := &Session{
+		ID:        id,
+		CSRFToken: csrf,
+		Identity:  identity,
+		CreatedAt: now,
+		LastSeen:  now,
+		ExpiresAt: now.Add(ss.maxAge),
+	}
+
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+
+	// 세션 생성 시 만료된 세션 정리
+	for sid, s := range ss.sessions {
+		if ss.expired(s, now) {
+			delete(ss.sessions, sid)
+		}
+	}
+	ss.sessions[id] = session
+
+	return *session, nil
+}
+
+// Get 세션 조회 및 마지막 사용 시간 갱신
+// 비밀번호 로그인 세션은 발급 이후 사용자가 삭제되거나 비밀번호, 역할이 변경되었으면 삭제
+//
+// Parameters:
+//   - id: 세션 ID
+//
+// Returns:
+//   - Session: 세션 복사본
+//   - bool: 유효(true), 없거나 만료(false)
+func (ss *SessionStore) Get(id string) (Session, bool) {
+	ss.mu.Lock()
+	session, exists := ss.sessions[id]
+	if !exists {
+		ss.mu.Unlock()
+		return Session{}, false
+	}
+
+	now := time.Now()
+	if ss.expired(session, now) {
+		delete(ss.sessions, id)
+		ss.mu.Unlock()
+		return Session{}, false
+	}
+	session.LastSeen = now
+	copied := *session
+	ss.mu.Unlock()
+
+	// 사용자 파일 확인은 세션 잠금 밖에서 수행
+	if ss.users != nil && copied.Identity.Method == MethodPassword &&
+		!ss.users.Valid(copied.Identity, copied.CreatedAt) {
+		ss.Delete(id)
+		return Session{}, false
+	}
+
+	return copied, true
+}
+
+// Delete 세션 삭제
+//
+// Parameters:
+//   - id: 세션 ID
+func (ss *SessionStore) Delete(id string) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	delete(ss.sessions, id)
+}
+
+// expired 세션 만료 여부 확인 (잠금 상태에서 호출)
+//
+// Parameters:
+//   - s: 세션
+//   - now: 현재 시간
+//
+// Returns:
+//   - bool: 만료(true), 유효(false)
+func (ss *SessionStore) expired(s *Session, now time.Time) bool {
+	return now.After(s.ExpiresAt) || now.Sub(s.LastSeen) > ss.idleTimeout
+}
+
+// maxTrackedLogins 로그인 실패를 추적하는 최대 사용자 수
+const maxTrackedLogins = 10000
+
+// LoginLimiter 사용자별 로그인 실패 잠금 관리 구조체
+type LoginLimiter struct {
+	mu          sync.Mutex
+	maxFailures int
+	lockout     time.Duration
+	failures    map[string]*loginFailure
+}
+
+// loginFailure 사용자별 로그인 실패 정보
+type loginFailure struct {
+	count       int
+	lockedUntil time.Time
+}
+
+// NewLoginLimiter 로그인 실패 잠금 관리자 생성
+//
+// Parameters:
+//   - maxFailures: 잠금 전 허용되는 연속 실패 횟수
+//   - lockout: 잠금 시간
+//
+// Returns:
+//   - *LoginLimiter
+func NewLoginLimiter(maxFailures int, lockout time.Duration) *LoginLimiter {
+	return &LoginLimiter{
+		maxFailures: maxFailures,
+		lockout:     lockout,
+		failures:    make(map[string]*loginFailure),
+	}
+}
+
+// Locked 사용자 잠금 여부 확인
+//
+// Parameters:
+//   - username: 사용자명
+//
+// Returns:
+//   - time.Duration: 남은 잠금 시간 (잠금 상태가 아니면 0)
+func (ll *LoginLimiter) Locked(username string) time.Duration {
+	ll.mu.Lock()
+	defer ll.mu.Unlock()
+
+	f, exists := ll.failures[username]
+	if !exists {
+		return 0
+	}
+	if remaining := time.Until(f.lockedUntil); remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
+// Failure 로그인 실패 기록 (연속 실패 횟수 초과 시 잠금)
+//
+// Parameters:
+//   - username: 사용자명
+//
+// Returns:
+//   - bool: 이번 실패로 잠금됨(true)
+func (ll *LoginLimiter) Failure(username string) bool {
+	ll.mu.Lock()
+	defer ll.mu.Unlock()
+
+	f, exists := ll.failures[username]
+	if !exists {
+		// 존재하지 않는 사용자명 대입 시 맵이 계속 커지지 않도록 잠금 해제된 항목 정리
+		if len(ll.failures) >= maxTrackedLogins {
+			now := time.Now()
+			for name, lf := range ll.failures {
+				if now.After(lf.lockedUntil) {
+					delete(ll.failures, name)
+				}
+			}
+		}
+		f = &loginFailure{}
+		ll.failures[username] = f
+	}
+
+	f.count++
+	if f.count >= ll.maxFailures {
+		f.count = 0
+		f.lockedUntil = time.Now().Add(ll.lockout)
+		return true
+	}
+	return false
+}
+
+// Success 로그인 성공 시 실패 기록 초기화
+//
+// Parameters:
+//   - username: 사용자명
+func (ll *LoginLimiter) Success(username string) {
+	ll.mu.Lock()
+	defer ll.mu.Unlock()
+	delete(ll.failures, username)
+}
@@ -0,0 +1,310 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// tokenPrefix 발급 토큰 접두어 (유출 시 식별 용도)
+const tokenPrefix = "unisys_"
+
+// Token 토큰 파일에 저장되는 토큰 정보 구조체 (토큰 원문은 저장하지 않음)
+type Token struct {
+	ID        string     `yaml:"id"`                  // 토큰 ID
+	Name      string     `yaml:"name"`                // 토큰명
+	Hash      string     `yaml:"hash"`                // 토큰 원문의 SHA-256 해시 (hex)
+	Roles     []string   `yaml:"roles,omitempty"`     // 역할
+	Scopes    []string   `yaml:"scopes,omitempty"`    // 권한 범위
+	CreatedAt time.Time  `yaml:"createdAt"`           // 생성 시간
+	ExpiresAt *time.Time `yaml:"expiresAt,omitempty"` // 만료 시간 (nil이면 만료 없음)
+}
+
+// tokenFile 토큰 파일 구조체
+type tokenFile struct {
+	Tokens []Token `yaml:"tokens"`
+}
+
+// TokenStore 토큰 파일 관리 구조체
+// 파일이 변경되면 다음 인증 시 자동으로 다시 로드함
+type TokenStore struct {
+	path    string
+	mu      sync.RWMutex
+	modTime time.Time
+	tokens  []Token
+	byHash  map[string]*Token
+}
+
+// NewTokenStore 토큰 저장소 생성
+//
+// Parameters:
+//   - path: 토큰 파일 경로
+//
+// Returns:
+//   - *TokenStore
+func NewTokenStore(path string) *TokenStore {
+	return &TokenStore{
+		path:   path,
+		byHash: make(map[string]*Token),
+	}
+}
+
+// Load 토큰 파일 로드 (파일이 없으면 빈 저장소)
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func (ts *TokenStore) Load() error {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	return ts.load()
+}
+
+// load 토큰 파일 로드 (잠금 상태에서 호출)
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func (ts *TokenStore) load() error {
+	info, err := os.Stat(ts.path)
+	if os.IsNotExist(err) {
+		ts.tokens = nil
+		ts.byHash = make(map[string]*Token)
+		ts.modTime = time.Time{}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to stat token file: %v", err)
+	}
+
+	data, err := os.ReadFile(ts.path)
+	if err != nil {
+		return fmt.Errorf("failed to read token file: %v", err)
+	}
+
+	var tf tokenFile
+	if err := yaml.Unmarshal(data, &tf); err != nil {
+		return fmt.Errorf("failed to parse token file: %v", err)
+	}
+
+	ts.tokens = tf.Tokens
+	ts.byHash = make(map[string]*Token, len(ts.tokens))
+	for i := range ts.tokens {
+		ts.byHash[ts.tokens[i].Hash] = &ts.tokens[i]
+	}
+	ts.modTime = info.ModTime()
+
+	return nil
+}
+
+// reloadIfChanged 토큰 파일이 변경된 경우 다시 로드
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func (ts *TokenStore) reloadIfChanged() error {
+	var modTime time.Time
+	if info, err := os.Stat(ts.path); err == nil {
+		modTime = info.ModTime()
+	}
+
+	ts.mu.RLock()
+	changed := !modTime.Equal(ts.modTime)
+	ts.mu.RUnlock()
+	if !changed {
+		return nil
+	}
+
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	return ts.load()
+}
+
+// save 토큰 파일 저장 (임시 파일에 기록 후 교체, 잠금 상태에서 호출)
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func (ts *TokenStore) save() error {
+	data, err := yaml.Marshal(tokenFile{Tokens: ts.tokens})
+	if err != nil {
+		return fmt.Errorf("failed to encode token file: %v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(ts.path), 0755); err != nil {
+		return fmt.Errorf("failed to make directory: %v", err)
+	}
+
+	tmpPath := ts.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0600); err != nil {
+		return fmt.Errorf("failed to write token file: %v", err)
+	}
+	if err := os.Rename(tmpPath, ts.path); err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("failed to replace token file: %v", err)
+	}
+
+	return nil
+}
+
+// Create 새 토큰 발급 및 저장
+//
+// Parameters:
+//   - name: 토큰명
+//   - roles: 역할
+//   - scopes: 권한 범위
+//   - ttl: 유효 기간 (0이면 만료 없음)
+//
+// Returns:
+//   - string: 토큰 원문 (발급 시에만 확인 가능)
+//   - Token: 저장된 토큰 정보
+//   - error: 성공(nil), 실패(error)
+func (ts *TokenStore) Create(name string, roles, scopes []string, ttl time.Duration) (string, Token, error) {
+	if err := ValidateRoles(roles); err != nil {
+		return "", Token{}, err
+	}
+	if err := ValidateScopes(scopes); err != nil {
+		return "", Token{}, err
+	}
+	if len(roles) == 0 && len(scopes) == 0 {
+		return "", Token{}, fmt.Errorf("at least one role or scope is required")
+	}
+
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if err := ts.load(); err != nil {
+		return "", Token{}, err
+	}
+
+	// 토큰 ID 및 원문 생성
+	idBytes := make([]byte, 8)
+	secret := make([]byte, 32)
+	if _, err := rand.Read(idBytes); err != nil {
+		return "", Token{}, fmt.Errorf("failed to generate token id: %v", err)
+	}
+	if _, err := rand.Read(secret); err != nil {
+		return "", Token{}, fmt.Errorf("failed to generate token: %v", err)
+	}
+	plain := tokenPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	token := Token{
+		ID:        hex.EncodeToString(idBytes),
+		Name:      name,
+		Hash:      hashToken(plain),
+		Roles:     roles,
+		Scopes:    scopes,
+		CreatedAt: time.Now().UTC().Truncate(time.Second),
+	}
+	if ttl > 0 {
+		expiresAt := token.CreatedAt.Add(ttl)
+		token.ExpiresAt = &expiresAt
+	}
+
+	ts.tokens = append(ts.tokens, token)
+	if err := ts.save(); err != nil {
+		return "", Token{}, err
+	}
+
+	return plain, token, nil
+}
+
+// List 저장된 토큰 목록 반환
+//
+// Returns:
+//   - []Token: 토큰 정보 목록
+//   - error: 성공(nil), 실패(error)
+func (ts *TokenStore) List() ([]Token, error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if err := ts.load(); err != nil {
+		return nil, err
+	}
+
+	return append([]Token{}, ts.tokens...), nil
+}
+
+// Revoke 토큰 폐기
+//
+// Parameters:
+//   - id: 토큰 ID
+//
+// Returns:
+//   - error: 성공(nil), 존재하지 않거나 실패(error)
+func (ts *TokenStore) Revoke(id string) error {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if err := ts.load(); err != nil {
+		return err
+	}
+
+	for i, token := range ts.tokens {
+		if token.ID == id {
+			ts.tokens = append(ts.tokens[:i], ts.tokens[i+1:]...)
+			return ts.save()
+		}
+	}
+
+	return fmt.Errorf("token does not exist (%s)", id)
+}
+
+// Authenticate 토큰 원문으로 인증
+//
+// Parameters:
+//   - plain: 토큰 원문
+//
+// Returns:
+//   - *Identity: 인증된 주체 정보
+//   - error: 성공(nil), 실패(error)
+func (ts *TokenStore) Authenticate(plain string) (*Identity, error) {
+	if err := ts.reloadIfChanged(); err != nil {
+		return nil, err
+	}
+
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+
+	token, exists := ts.byHash[hashToken(plain)]
+	if !exists {
+		return nil, fmt.Errorf("invalid token")
+	}
+	if token.ExpiresAt != nil && time.Now().After(*token.ExpiresAt) {
+		return nil, fmt.Errorf("token expired (%s)", token.ID)
+	}
+
+	return NewIdentity(token.Name, MethodToken, token.Roles, token.Scopes), nil
+}
+
+// hashToken 토큰 원문의 SHA-256 해시 계산
+//
+// Parameters:
+//   - plain: 토큰 원문
+//
+// Returns:
+//   - string: hex 인코딩된 해시
+func hashToken(plain string) string {
+	sum := sha256.Sum256([]byte(plain))
+	return hex.EncodeToString(sum[:])
+}
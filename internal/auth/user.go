@@ -0,0 +1,394 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"gopkg.in/yaml.v3"
+)
+
+// bcryptCost 비밀번호 해시 비용
+const bcryptCost = 12
+
+// 비밀번호 최소 길이
+const minPasswordLength = 8
+
+// ErrInvalidCredentials 사용자명 또는 비밀번호 불일치
+var ErrInvalidCredentials = errors.New("invalid username or password")
+
+// 존재하지 않는 사용자 로그인 시 응답 시간을 맞추기 위한 해시 (첫 로그인 시 생성)
+var (
+	dummyHashOnce sync.Once
+	dummyHash     []byte
+	dummyHashErr  error
+)
+
+// getDummyHash 더미 해시 조회 (처음 호출 시 생성)
+//
+// Returns:
+//   - []byte: 더미 해시
+//   - error: 성공(nil), 실패(error)
+func getDummyHash() ([]byte, error) {
+	dummyHashOnce.Do(func() {
+		dummyHash, dummyHashErr = bcrypt.GenerateFromPassword([]byte("unisys-dummy-password"), bcryptCost)
+		if dummyHashErr != nil {
+			dummyHashErr = fmt.Errorf("failed to generate dummy hash: %v", dummyHashErr)
+		}
+	})
+	return dummyHash, dummyHashErr
+}
+
+// User 사용자 파일에 저장되는 사용자 정보 구조체
+type User struct {
+	Username  string    `yaml:"username"`  // 사용자명
+	Hash      string    `yaml:"hash"`      // bcrypt 비밀번호 해시
+	Roles     []string  `yaml:"roles"`     // 역할
+	CreatedAt time.Time `yaml:"createdAt"` // 생성 시간
+	UpdatedAt time.Time `yaml:"updatedAt"` // 비밀번호 변경 시간
+}
+
+// userFile 사용자 파일 구조체
+type userFile struct {
+	Users []User `yaml:"users"`
+}
+
+// UserStore 사용자 파일 관리 구조체
+// 파일이 변경되면 다음 로그인 시 자동으로 다시 로드함
+type UserStore struct {
+	path    string
+	mu      sync.RWMutex
+	modTime time.Time
+	users   []User
+}
+
+// NewUserStore 사용자 저장소 생성
+//
+// Parameters:
+//   - path: 사용자 파일 경로
+//
+// Returns:
+//   - *UserStore
+func NewUserStore(path string) *UserStore {
+	return &UserStore{path: path}
+}
+
+// Load 사용자 파일 로드 (파일이 없으면 빈 저장소)
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func (us *UserStore) Load() error {
+	us.mu.Lock()
+	defer us.mu.Unlock()
+	return us.load()
+}
+
+// load 사용자 파일 로드 (잠금 상태에서 호출)
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func (us *UserStore) load() error {
+	info, err := os.Stat(us.path)
+	if os.IsNotExist(err) {
+		us.users = nil
+		us.modTime = time.Time{}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to stat user file: %v", err)
+	}
+
+	data, err := os.ReadFile(us.path)
+	if err != nil {
+		return fmt.Errorf("failed to read user file: %v", err)
+	}
+
+	var uf userFile
+	if err := yaml.Unmarshal(data, &uf); err != nil {
+		return fmt.Errorf("failed to parse user file: %v", err)
+	}
+
+	us.users = uf.Users
+	us.modTime = info.ModTime()
+
+	return nil
+}
+
+// reloadIfChanged 사용자 파일이 변경된 경우 다시 로드
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func (us *UserStore) reloadIfChanged() error {
+	var modTime time.Time
+	if info, err := os.Stat(us.path); err == nil {
+		modTime = info.ModTime()
+	}
+
+	us.mu.RLock()
+	changed := !modTime.Equal(us.modTime)
+	us.mu.RUnlock()
+	if !changed {
+		return nil
+	}
+
+	us.mu.Lock()
+	defer us.mu.Unlock()
+	return us.load()
+}
+
+// save 사용자 파일 저장 (임시 파일에 기록 후 교체, 잠금 상태에서 호출)
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func (us *UserStore) save() error {
+	data, err := yaml.Marshal(userFile{Users: us.users})
+	if err != nil {
+		return fmt.Errorf("failed to encode user file: %v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(us.path), 0755); err != nil {
+		return fmt.Errorf("failed to make directory: %v", err)
+	}
+
+	tmpPath := us.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0600); err != nil {
+		return fmt.Errorf("failed to write user file: %v", err)
+	}
+	if err := os.Rename(tmpPath, us.path); err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("failed to replace user file: %v", err)
+	}
+
+	return nil
+}
+
+// find 사용자 검색 (잠금 상태에서 호출)
+//
+// Parameters:
+//   - username: 사용자명
+//
+// Returns:
+//   - int: 사용자 인덱스 (없으면 -1)
+func (us *UserStore) find(username string) int {
+	for i := range us.users {
+		if us.users[i].Username == username {
+			return i
+		}
+	}
+	return -1
+}
+
+// hashPassword 비밀번호 bcrypt 해시 생성
+//
+// Parameters:
+//   - password: 비밀번호
+//
+// Returns:
+//   - string: bcrypt 해시
+//   - error: 성공(nil), 실패(error)
+func hashPassword(password string) (string, error) {
+	if len(password) < minPasswordLength {
+		return "", fmt.Errorf("password must be at least %d characters", minPasswordLength)
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcryptCost)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password: %v", err)
+	}
+	return string(hash), nil
+}
+
+// Add 사용자 추가
+//
+// Parameters:
+//   - username: 사용자명
+//   - password: 비밀번호
+//   - roles: 역할
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func (us *UserStore) Add(username, password string, roles []string) error {
+	if username == "" {
+		return fmt.Errorf("username is required")
+	}
+	if err := ValidateRoles(roles); err != nil {
+		return err
+	}
+	hash, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	us.mu.Lock()
+	defer us.mu.Unlock()
+
+	if err := us.load(); err != nil {
+		return err
+	}
+	if us.find(username) >= 0 {
+		return fmt.Errorf("user already exists (%s)", username)
+	}
+
+	now := time.Now().UTC()
+	us.users = append(us.users, User{
+		Username:  username,
+		Hash:      hash,
+		Roles:     uniqueSorted(roles),
+		CreatedAt: now.Truncate(time.Second),
+		UpdatedAt: now,
+	})
+
+	return us.save()
+}
+
+// SetPassword 사용자 비밀번호 변경
+//
+// Parameters:
+//   - username: 사용자명
+//   - password: 새 비밀번호
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func (us *UserStore) SetPassword(username, password string) error {
+	hash, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	us.mu.Lock()
+	defer us.mu.Unlock()
+
+	if err := us.load(); err != nil {
+		return err
+	}
+	idx := us.find(username)
+	if idx < 0 {
+		return fmt.Errorf("user does not exist (%s)", username)
+	}
+
+	// 변경 이전에 발급된 세션을 구분할 수 있도록 초 단위로 자르지 않음
+	us.users[idx].Hash = hash
+	us.users[idx].UpdatedAt = time.Now().UTC()
+
+	return us.save()
+}
+
+// Delete 사용자 삭제
+//
+// Parameters:
+//   - username: 사용자명
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func (us *UserStore) Delete(username string) error {
+	us.mu.Lock()
+	defer us.mu.Unlock()
+
+	if err := us.load(); err != nil {
+		return err
+	}
+	idx := us.find(username)
+	if idx < 0 {
+		return fmt.Errorf("user does not exist (%s)", username)
+	}
+
+	us.users = append(us.users[:idx], us.users[idx+1:]...)
+
+	return us.save()
+}
+
+// Authenticate 사용자명과 비밀번호로 인증
+//
+// Parameters:
+//   - username: 사용자명
+//   - password: 비밀번호
+//
+// Returns:
+//   - *Identity: 인증된 주체 정보
+//   - error: 성공(nil), 불일치(ErrInvalidCredentials), 실패(error)
+func (us *UserStore) Authenticate(username, password string) (*Identity, error) {
+	if err := us.reloadIfChanged(); err != nil {
+		return nil, err
+	}
+
+	us.mu.RLock()
+	idx := us.find(username)
+	var user User
+	if idx >= 0 {
+		user = us.users[idx]
+	}
+	us.mu.RUnlock()
+
+	// 존재하지 않는 사용자도 동일한 시간이 걸리도록 더미 해시와 비교
+	hash := []byte(user.Hash)
+	if idx < 0 {
+		var err error
+		if hash, err = getDummyHash(); err != nil {
+			return nil, err
+		}
+	}
+	if err := bcrypt.CompareHashAndPassword(hash, []byte(password)); err != nil || idx < 0 {
+		return nil, ErrInvalidCredentials
+	}
+
+	return NewIdentity(user.Username, MethodPassword, user.Roles, nil), nil
+}
+
+// Valid 세션 발급 이후 사용자가 삭제되거나 변경되지 않았는지 확인
+// 사용자 파일을 확인할 수 없으면 유효하지 않은 것으로 처리
+//
+// Parameters:
+//   - identity: 세션의 인증 주체 정보
+//   - issuedAt: 세션 발급 시간
+//
+// Returns:
+//   - bool: 유효(true), 삭제 또는 변경됨(false)
+func (us *UserStore) Valid(identity *Identity, issuedAt time.Time) bool {
+	if err := us.reloadIfChanged(); err != nil {
+		return false
+	}
+
+	us.mu.RLock()
+	defer us.mu.RUnlock()
+
+	idx := us.find(identity.Name)
+	if idx < 0 {
+		return false
+	}
+	user := us.users[idx]
+	if user.UpdatedAt.After(issuedAt) {
+		return false
+	}
+
+	// 사용자 파일을 직접 수정한 경우도 있으므로 순서, 중복과 관계없이 집합으로 비교
+	roles := uniqueSorted(user.Roles)
+	sessionRoles := uniqueSorted(identity.Roles)
+	if len(roles) != len(sessionRoles) {
+		return false
+	}
+	for i := range roles {
+		if roles[i] != sessionRoles[i] {
+			return false
+		}
+	}
+	return true
+}
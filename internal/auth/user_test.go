@@ -0,0 +1,113 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package auth
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newTestUserStore 테스트용 사용자 저장소 생성 (임시 디렉터리 사용)
+func newTestUserStore(t *testing.T) *UserStore {
+	t.Helper()
+	return NewUserStore(filepath.Join(t.TempDir(), "users.yaml"))
+}
+
+func TestUserStoreValid(t *testing.T) {
+	us := newTestUserStore(t)
+	if err := us.Add("alice", "password123", []string{"viewer", "admin", "viewer"}); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if err := us.Add("bob", "password123", []string{"operator"}); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	issued := time.Now()
+
+	tests := []struct {
+		name     string
+		identity *Identity
+		issuedAt time.Time
+		want     bool
+	}{
+		{"same roles", NewIdentity("alice", MethodPassword, []string{"admin", "viewer"}, nil), issued, true},
+		{"roles out of order", &Identity{Name: "alice", Roles: []string{"viewer", "admin"}}, issued, true},
+		{"duplicate roles", &Identity{Name: "alice", Roles: []string{"admin", "viewer", "admin"}}, issued, true},
+		{"missing role", NewIdentity("alice", MethodPassword, []string{"admin"}, nil), issued, false},
+		{"extra role", NewIdentity("bob", MethodPassword, []string{"operator", "viewer"}, nil), issued, false},
+		{"issued before update", NewIdentity("bob", MethodPassword, []string{"operator"}, nil),
+			issued.Add(-time.Minute), false},
+		{"unknown user", NewIdentity("carol", MethodPassword, []string{"viewer"}, nil), issued, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := us.Valid(tt.identity, tt.issuedAt); got != tt.want {
+				t.Errorf("Valid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUserStoreValidAfterChange(t *testing.T) {
+	us := newTestUserStore(t)
+	if err := us.Add("alice", "password123", []string{"viewer"}); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	identity, err := us.Authenticate("alice", "password123")
+	if err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+	issued := time.Now()
+
+	if err := us.SetPassword("alice", "password456"); err != nil {
+		t.Fatalf("SetPassword: %v", err)
+	}
+	if us.Valid(identity, issued) {
+		t.Error("session issued before password change is still valid")
+	}
+
+	if err := us.Delete("alice"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if us.Valid(identity, time.Now()) {
+		t.Error("session of deleted user is still valid")
+	}
+}
+
+func TestSessionWithUnorderedRoles(t *testing.T) {
+	us := newTestUserStore(t)
+	if err := us.Add("alice", "password123", []string{"viewer", "admin", "viewer"}); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	identity, err := us.Authenticate("alice", "password123")
+	if err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+
+	ss := NewSessionStore(time.Minute, time.Hour, us)
+	session, err := ss.Create(identity)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	got, ok := ss.Get(session.ID)
+	if !ok {
+		t.Fatal("session dropped on first use")
+	}
+	if len(got.Identity.Roles) != 2 || got.Identity.Roles[0] != "admin" || got.Identity.Roles[1] != "viewer" {
+		t.Errorf("session roles = %v, want [admin viewer]", got.Identity.Roles)
+	}
+}
@@ -0,0 +1,296 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+/*
+Package certs TLS 서버 인증서 로드, SNI 선택 및 무중단 갱신 패키지
+*/
+package certs
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/meloncoffee/unisys/internal/logger"
+)
+
+// Pair 인증서/키 파일 쌍
+type Pair struct {
+	CertFile string
+	KeyFile  string
+}
+
+// Info 로드된 인증서 정보 구조체
+type Info struct {
+	CertFile string    // 인증서 파일 경로
+	Subject  string    // 인증서 subject
+	Names    []string  // 인증서 DNS/IP SAN 목록
+	NotAfter time.Time // 인증서 만료 시간
+}
+
+// 통계 조회용 현재 인증서 저장소
+var current atomic.Pointer[Store]
+
+// fileStamp 파일 변경 여부 판단용 정보 (심볼릭 링크 교체도 감지되도록 inode 포함)
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+	inode   uint64
+}
+
+// entry 인증서/키 파일 쌍과 로드된 인증서
+type entry struct {
+	pair      Pair
+	certStamp fileStamp
+	keyStamp  fileStamp
+	cert      *tls.Certificate
+}
+
+// Store 인증서 저장소 구조체
+type Store struct {
+	mu      sync.RWMutex
+	entries []*entry // 첫 번째 인증서가 기본 인증서
+}
+
+// statFile 파일 변경 판단용 정보 조회
+//
+// Parameters:
+//   - path: 파일 경로
+//
+// Returns:
+//   - fileStamp: 파일 정보
+//   - error: 성공(nil), 실패(error)
+func statFile(path string) (fileStamp, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fileStamp{}, err
+	}
+	stamp := fileStamp{modTime: info.ModTime(), size: info.Size()}
+	if sys, ok := info.Sys().(*syscall.Stat_t); ok {
+		stamp.inode = sys.Ino
+	}
+	return stamp, nil
+}
+
+// load 인증서/키 파일 쌍 로드
+//
+// Parameters:
+//   - pair: 인증서/키 파일 쌍
+//
+// Returns:
+//   - *entry: 로드된 인증서
+//   - error: 성공(nil), 실패(error)
+func load(pair Pair) (*entry, error) {
+	// 로드 도중 파일이 교체되면 다음 확인 때 다시 로드되도록 로드 전에 정보 조회
+	certStamp, err := statFile(pair.CertFile)
+	if err != nil {
+		return nil, err
+	}
+	keyStamp, err := statFile(pair.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := tls.LoadX509KeyPair(pair.CertFile, pair.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	if cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0]); err != nil {
+		return nil, err
+	}
+
+	return &entry{pair: pair, certStamp: certStamp, keyStamp: keyStamp, cert: &cert}, nil
+}
+
+// NewStore 인증서 저장소 생성
+//
+// Parameters:
+//   - pairs: 인증서/키 파일 쌍 목록 (첫 번째가 기본 인증서)
+//
+// Returns:
+//   - *Store: 인증서 저장소
+//   - error: 성공(nil), 실패(error)
+func NewStore(pairs []Pair) (*Store, error) {
+	if len(pairs) == 0 {
+		return nil, fmt.Errorf("no certificate configured")
+	}
+
+	s := &Store{entries: make([]*entry, 0, len(pairs))}
+	for _, pair := range pairs {
+		if pair.CertFile == "" || pair.KeyFile == "" {
+			return nil, fmt.Errorf("certificate and key file are required (cert: %s, key: %s)",
+				pair.CertFile, pair.KeyFile)
+		}
+		e, err := load(pair)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", pair.CertFile, err)
+		}
+		s.entries = append(s.entries, e)
+	}
+
+	current.Store(s)
+	return s, nil
+}
+
+// Reload 변경된 인증서 다시 로드 (로드 실패 시 기존 인증서 유지)
+//
+// Parameters:
+//   - force: 변경 여부와 관계없이 모두 로드
+//
+// Returns:
+//   - int: 다시 로드된 인증서 수
+func (s *Store) Reload(force bool) int {
+	s.mu.RLock()
+	entries := append([]*entry(nil), s.entries...)
+	s.mu.RUnlock()
+
+	reloaded := 0
+	for i, old := range entries {
+		if !force {
+			certStamp, certErr := statFile(old.pair.CertFile)
+			keyStamp, keyErr := statFile(old.pair.KeyFile)
+			if certErr == nil && keyErr == nil &&
+				certStamp == old.certStamp && keyStamp == old.keyStamp {
+				continue
+			}
+		}
+
+		e, err := load(old.pair)
+		if err != nil {
+			// 인증서와 키가 따로 교체되는 도중일 수 있으므로 다음 확인 때 다시 시도
+			logger.Log.LogWarn("failed to reload tls certificate %s, keeping the current one: %v",
+				old.pair.CertFile, err)
+			continue
+		}
+
+		s.mu.Lock()
+		s.entries[i] = e
+		s.mu.Unlock()
+		reloaded++
+
+		logger.Log.LogInfo("tls certificate reloaded (file: %s, subject: %s, not after: %s)",
+			e.pair.CertFile, e.cert.Leaf.Subject.String(), e.cert.Leaf.NotAfter.Format(time.RFC3339))
+	}
+	return reloaded
+}
+
+// Watch 인증서 파일 변경 주기적 확인 및 SIGHUP 수신 시 강제 로드
+//
+// Parameters:
+//   - ctx: 종료 컨텍스트
+//   - interval: 파일 변경 확인 주기
+func (s *Store) Watch(ctx context.Context, interval time.Duration) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer func() {
+		signal.Stop(hup)
+		// 감시 종료 후 SIGHUP으로 프로세스가 종료되지 않도록 다시 무시
+		signal.Ignore(syscall.SIGHUP)
+	}()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.Reload(false)
+		case <-hup:
+			logger.Log.LogInfo("received SIGHUP, reloading tls certificates")
+			s.Reload(true)
+		}
+	}
+}
+
+// GetCertificate SNI 서버 이름에 맞는 인증서 선택 (tls.Config.GetCertificate)
+// 이름이 일치하는 인증서 중 클라이언트가 지원하는 것을 우선 선택하고,
+// 일치하는 인증서가 없으면 기본 인증서 사용
+//
+// Parameters:
+//   - hello: TLS ClientHello 정보
+//
+// Returns:
+//   - *tls.Certificate: 선택된 인증서
+//   - error: 성공(nil), 실패(error)
+func (s *Store) GetCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if hello.ServerName != "" {
+		var nameMatch *tls.Certificate
+		for _, e := range s.entries {
+			if e.cert.Leaf.VerifyHostname(hello.ServerName) != nil {
+				continue
+			}
+			if hello.SupportsCertificate(e.cert) == nil {
+				return e.cert, nil
+			}
+			if nameMatch == nil {
+				nameMatch = e.cert
+			}
+		}
+		if nameMatch != nil {
+			return nameMatch, nil
+		}
+	}
+
+	return s.entries[0].cert, nil
+}
+
+// Infos 로드된 인증서 정보 목록
+//
+// Returns:
+//   - []Info: 인증서 정보 목록
+func (s *Store) Infos() []Info {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	infos := make([]Info, 0, len(s.entries))
+	for _, e := range s.entries {
+		leaf := e.cert.Leaf
+		names := append([]string(nil), leaf.DNSNames...)
+		for _, ip := range leaf.IPAddresses {
+			names = append(names, ip.String())
+		}
+		infos = append(infos, Info{
+			CertFile: e.pair.CertFile,
+			Subject:  leaf.Subject.String(),
+			Names:    names,
+			NotAfter: leaf.NotAfter,
+		})
+	}
+	return infos
+}
+
+// GetInfos 현재 사용 중인 인증서 정보 목록 조회
+//
+// Returns:
+//   - []Info: 인증서 정보 목록 (TLS 미사용 시 nil)
+func GetInfos() []Info {
+	if s := current.Load(); s != nil {
+		return s.Infos()
+	}
+	return nil
+}
@@ -0,0 +1,387 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package certs
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// 인증서 용도
+const (
+	UsageServer = "server" // TLS 서버 인증서
+	UsageClient = "client" // TLS 클라이언트 인증서 (mTLS)
+	UsageCA     = "ca"     // 인증서 발급용 CA
+)
+
+// 키 종류
+const (
+	KeyECDSA = "ecdsa" // ECDSA P-256
+	KeyRSA   = "rsa"   // RSA 3072
+)
+
+// ErrExists 출력 파일이 이미 존재
+var ErrExists = errors.New("file already exists")
+
+// Request 인증서 생성 요청 구조체
+type Request struct {
+	CommonName string        // 인증서 subject CN
+	Hosts      []string      // SAN 목록 (DNS 이름, IP, URI)
+	Usage      string        // 인증서 용도 (server, client, ca)
+	KeyType    string        // 키 종류 (ecdsa, rsa)
+	Validity   time.Duration // 유효 기간
+}
+
+// Issued 생성된 인증서 및 키
+type Issued struct {
+	Cert    *x509.Certificate
+	CertPEM []byte
+	KeyPEM  []byte
+}
+
+// generateKey 개인키 생성
+//
+// Parameters:
+//   - keyType: 키 종류 (ecdsa, rsa)
+//
+// Returns:
+//   - crypto.Signer: 개인키
+//   - error: 성공(nil), 실패(error)
+func generateKey(keyType string) (crypto.Signer, error) {
+	switch keyType {
+	case KeyECDSA, "":
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case KeyRSA:
+		return rsa.GenerateKey(rand.Reader, 3072)
+	default:
+		return nil, fmt.Errorf("unknown key type %q (%s, %s)", keyType, KeyECDSA, KeyRSA)
+	}
+}
+
+// applyHosts SAN 목록을 IP, URI, DNS 이름으로 분류하여 템플릿에 설정
+//
+// Parameters:
+//   - tmpl: 인증서 템플릿
+//   - hosts: SAN 목록
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func applyHosts(tmpl *x509.Certificate, hosts []string) error {
+	for _, host := range hosts {
+		host = strings.TrimSpace(host)
+		switch {
+		case host == "":
+		case net.ParseIP(host) != nil:
+			tmpl.IPAddresses = append(tmpl.IPAddresses, net.ParseIP(host))
+		case strings.Contains(host, "://"):
+			u, err := url.Parse(host)
+			if err != nil {
+				return fmt.Errorf("invalid URI %q: %v", host, err)
+			}
+			tmpl.URIs = append(tmpl.URIs, u)
+		default:
+			tmpl.DNSNames = append(tmpl.DNSNames, strings.ToLower(host))
+		}
+	}
+	return nil
+}
+
+// Generate 인증서 생성 (parent가 nil이면 자체 서명)
+//
+// Parameters:
+//   - req: 인증서 생성 요청
+//   - parent: 발급 CA 인증서 (자체 서명 시 nil)
+//   - parentKey: 발급 CA 개인키 (자체 서명 시 nil)
+//
+// Returns:
+//   - *Issued: 생성된 인증서 및 키
+//   - error: 성공(nil), 실패(error)
+func Generate(req Request, parent *x509.Certificate, parentKey crypto.Signer) (*Issued, error) {
+	if req.CommonName == "" {
+		return nil, fmt.Errorf("common name is required")
+	}
+	if req.Validity <= 0 {
+		return nil, fmt.Errorf("validity must be positive")
+	}
+
+	key, err := generateKey(req.KeyType)
+	if err != nil {
+		return nil, err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: req.CommonName, Organization: []string{"unisys"}},
+		// 시스템 간 시간 오차 허용
+		NotBefore:             now.Add(-5 * time.Minute),
+		NotAfter:              now.Add(req.Validity),
+		BasicConstraintsValid: true,
+	}
+	if err := applyHosts(tmpl, req.Hosts); err != nil {
+		return nil, err
+	}
+
+	switch req.Usage {
+	case UsageCA:
+		tmpl.IsCA = true
+		tmpl.MaxPathLenZero = true
+		tmpl.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature
+	case UsageServer, UsageClient:
+		tmpl.KeyUsage = x509.KeyUsageDigitalSignature
+		if _, isRSA := key.(*rsa.PrivateKey); isRSA {
+			tmpl.KeyUsage |= x509.KeyUsageKeyEncipherment
+		}
+		tmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+		if req.Usage == UsageClient {
+			tmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+		}
+		// 서버 인증서는 SAN이 없으면 CN을 DNS 이름으로 사용 (최신 클라이언트는 CN을 검사하지 않음)
+		if req.Usage == UsageServer && len(tmpl.DNSNames)+len(tmpl.IPAddresses)+len(tmpl.URIs) == 0 {
+			if err := applyHosts(tmpl, []string{req.CommonName}); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown certificate usage %q", req.Usage)
+	}
+
+	// 자체 서명
+	if parent == nil || parentKey == nil {
+		parent, parentKey = tmpl, key
+	} else if tmpl.NotAfter.After(parent.NotAfter) {
+		// 발급 CA보다 오래 유효한 인증서는 검증에 실패하므로 CA 만료 시간으로 제한
+		tmpl.NotAfter = parent.NotAfter
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, key.Public(), parentKey)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Issued{
+		Cert:    cert,
+		CertPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		KeyPEM:  pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}),
+	}, nil
+}
+
+// LoadCA 인증서 발급용 CA 인증서 및 개인키 로드
+//
+// Parameters:
+//   - certFile: CA 인증서 파일 경로
+//   - keyFile: CA 개인키 파일 경로
+//
+// Returns:
+//   - *x509.Certificate: CA 인증서
+//   - crypto.Signer: CA 개인키
+//   - error: 성공(nil), 실패(error)
+func LoadCA(certFile, keyFile string) (*x509.Certificate, crypto.Signer, error) {
+	certs, err := ReadCertificates(certFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	ca := certs[0]
+	if !ca.IsCA {
+		return nil, nil, fmt.Errorf("%s is not a CA certificate", certFile)
+	}
+
+	keyPEM, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, nil, fmt.Errorf("no PEM data found in %s", keyFile)
+	}
+
+	var key interface{}
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse %s: %v", keyFile, err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, nil, fmt.Errorf("unsupported key type in %s", keyFile)
+	}
+	return ca, signer, nil
+}
+
+// ReadCertificates PEM 파일의 인증서 목록 읽기
+//
+// Parameters:
+//   - path: 인증서 파일 경로
+//
+// Returns:
+//   - []*x509.Certificate: 인증서 목록 (파일 내 순서)
+//   - error: 성공(nil), 실패(error)
+func ReadCertificates(path string) ([]*x509.Certificate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", path, err)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no certificate found in %s", path)
+	}
+	return certs, nil
+}
+
+// WriteFiles 인증서 및 개인키 파일 저장 (개인키는 소유자만 읽을 수 있도록 0600)
+//
+// Parameters:
+//   - issued: 생성된 인증서 및 키
+//   - certFile: 인증서 파일 경로
+//   - keyFile: 개인키 파일 경로
+//   - overwrite: 기존 파일 덮어쓰기 여부
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func WriteFiles(issued *Issued, certFile, keyFile string, overwrite bool) error {
+	if !overwrite {
+		for _, path := range []string{certFile, keyFile} {
+			if _, err := os.Stat(path); err == nil {
+				return fmt.Errorf("%w: %s", ErrExists, path)
+			}
+		}
+	}
+
+	// 인증서 교체를 감시 중인 서버가 키/인증서 불일치 상태를 읽지 않도록
+	// 임시 파일에 쓴 뒤 키, 인증서 순서로 교체
+	files := []struct {
+		path string
+		data []byte
+		mode os.FileMode
+	}{
+		{keyFile, issued.KeyPEM, 0600},
+		{certFile, issued.CertPEM, 0644},
+	}
+	for _, f := range files {
+		if err := os.MkdirAll(filepath.Dir(f.path), 0755); err != nil {
+			return err
+		}
+		tmp := f.path + ".tmp"
+		os.Remove(tmp)
+		if err := os.WriteFile(tmp, f.data, f.mode); err != nil {
+			return err
+		}
+		if err := os.Rename(tmp, f.path); err != nil {
+			os.Remove(tmp)
+			return err
+		}
+	}
+	return nil
+}
+
+// DefaultHosts 로컬 서버 인증서 기본 SAN 목록 (호스트 이름, localhost, 루프백 주소)
+//
+// Returns:
+//   - []string: SAN 목록
+func DefaultHosts() []string {
+	hosts := []string{"localhost", "127.0.0.1", "::1"}
+	if hostname, err := os.Hostname(); err == nil && hostname != "" && hostname != "localhost" {
+		hosts = append([]string{hostname}, hosts...)
+	}
+	return hosts
+}
+
+// EnsureSelfSigned 인증서 또는 개인키 파일이 없으면 자체 서명 서버 인증서 생성
+//
+// Parameters:
+//   - certFile: 인증서 파일 경로
+//   - keyFile: 개인키 파일 경로
+//
+// Returns:
+//   - *x509.Certificate: 생성된 인증서 (파일이 모두 존재하면 nil)
+//   - error: 성공(nil), 실패(error)
+func EnsureSelfSigned(certFile, keyFile string) (*x509.Certificate, error) {
+	_, certErr := os.Stat(certFile)
+	_, keyErr := os.Stat(keyFile)
+	if certErr == nil && keyErr == nil {
+		return nil, nil
+	}
+	// 둘 중 하나만 있으면 다른 인증서의 키일 수 있으므로 덮어쓰지 않음
+	if certErr == nil || keyErr == nil {
+		return nil, fmt.Errorf("only one of %s and %s exists", certFile, keyFile)
+	}
+
+	hosts := DefaultHosts()
+	issued, err := Generate(Request{
+		CommonName: hosts[0],
+		Hosts:      hosts,
+		Usage:      UsageServer,
+		KeyType:    KeyECDSA,
+		Validity:   365 * 24 * time.Hour,
+	}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := WriteFiles(issued, certFile, keyFile, false); err != nil {
+		return nil, err
+	}
+	return issued.Cert, nil
+}
@@ -0,0 +1,182 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+/*
+Package forecast 리소스 고갈 시점 예측 패키지
+*/
+package forecast
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/meloncoffee/unisys/config"
+)
+
+// 키별 최대 보관 샘플 수 (lookback window를 이 개수로 나눈 간격으로 샘플링)
+const maxSamples = 1000
+
+// Key 예측 대상 식별 구조체
+type Key struct {
+	Resource   string `json:"resource"`             // 리소스 종류 (disk, memory)
+	MountPoint string `json:"mountPoint,omitempty"` // 마운트 경로 (디스크일 경우)
+}
+
+// Forecast 예측 결과 구조체
+type Forecast struct {
+	Key
+	UsageRate            float64  `json:"usageRate"`            // 현재 사용률 (%)
+	SlopePerHour         float64  `json:"slopePerHour"`         // 시간당 사용률 증가량 (%)
+	PredictedFullSeconds *float64 `json:"predictedFullSeconds"` // 사용률 100% 도달까지 남은 시간 (증가 추세가 아닐 경우 nil)
+	Samples              int      `json:"samples"`              // 예측에 사용된 샘플 수
+}
+
+// sample 사용률 샘플 구조체
+type sample struct {
+	t     time.Time // 수집 시간 (병합된 샘플은 평균 시간)
+	value float64   // 사용률 (병합된 샘플은 평균 값)
+	start time.Time // 병합 구간 시작 시간
+	count int       // 병합된 샘플 수
+}
+
+var (
+	// 전역 사용률 이력 변수 선언
+	histories = make(map[Key][]sample)
+	histMutex sync.RWMutex
+)
+
+// Observe 사용률 샘플을 이력에 추가하고 lookback window를 벗어난 샘플 제거
+//
+// Parameters:
+//   - key: 예측 대상 식별 정보
+//   - usageRate: 사용률 (%)
+//   - t: 샘플 수집 시간
+func Observe(key Key, usageRate float64, t time.Time) {
+	histMutex.Lock()
+	defer histMutex.Unlock()
+
+	window := time.Duration(config.Conf.Forecast.LookbackWindow) * time.Second
+	history := histories[key]
+
+	// 샘플 간격이 너무 좁으면 마지막 샘플에 병합 (시간과 값 모두 평균하여 회귀 기울기 왜곡 방지)
+	if n := len(history); n > 0 && t.Sub(history[n-1].start) < window/maxSamples {
+		last := &history[n-1]
+		last.count++
+		last.t = last.t.Add(t.Sub(last.t) / time.Duration(last.count))
+		last.value += (usageRate - last.value) / float64(last.count)
+	} else {
+		history = append(history, sample{t: t, value: usageRate, start: t, count: 1})
+	}
+
+	// lookback window를 벗어난 샘플 제거
+	idx := 0
+	for idx < len(history) && t.Sub(history[idx].t) > window {
+		idx++
+	}
+	histories[key] = append(history[:0], history[idx:]...)
+}
+
+// Remove 더 이상 수집되지 않는 대상의 이력 제거
+//
+// Parameters:
+//   - key: 예측 대상 식별 정보
+func Remove(key Key) {
+	histMutex.Lock()
+	defer histMutex.Unlock()
+	delete(histories, key)
+}
+
+// GetForecasts 전체 대상에 대한 선형 회귀 기반 고갈 시점 예측
+//
+// Returns:
+//   - []Forecast: 리소스 종류, 마운트 경로 순으로 정렬된 예측 결과 목록
+func GetForecasts() []Forecast {
+	histMutex.RLock()
+	defer histMutex.RUnlock()
+
+	forecasts := make([]Forecast, 0, len(histories))
+	for key, history := range histories {
+		if len(history) == 0 {
+			continue
+		}
+		forecasts = append(forecasts, predict(key, history))
+	}
+
+	sort.Slice(forecasts, func(i, j int) bool {
+		if forecasts[i].Resource != forecasts[j].Resource {
+			return forecasts[i].Resource < forecasts[j].Resource
+		}
+		return forecasts[i].MountPoint < forecasts[j].MountPoint
+	})
+
+	return forecasts
+}
+
+// predict 최소 제곱 선형 회귀로 사용률 100% 도달 시점 예측
+//
+// Parameters:
+//   - key: 예측 대상 식별 정보
+//   - history: 사용률 이력
+//
+// Returns:
+//   - Forecast: 예측 결과
+func predict(key Key, history []sample) Forecast {
+	n := len(history)
+	f := Forecast{
+		Key:       key,
+		UsageRate: history[n-1].value,
+		Samples:   n,
+	}
+
+	if n < config.Conf.Forecast.MinSamples || n < 2 {
+		return f
+	}
+
+	// x: 첫 샘플 기준 경과 시간(초), y: 사용률
+	var sumX, sumY, sumXY, sumXX float64
+	origin := history[0].t
+	for _, s := range history {
+		x := s.t.Sub(origin).Seconds()
+		sumX += x
+		sumY += s.value
+		sumXY += x * s.value
+		sumXX += x * x
+	}
+
+	denom := float64(n)*sumXX - sumX*sumX
+	if denom == 0 {
+		return f
+	}
+	slope := (float64(n)*sumXY - sumX*sumY) / denom
+	intercept := (sumY - slope*sumX) / float64(n)
+	f.SlopePerHour = slope * 3600
+
+	// 증가 추세가 아니면 고갈 시점 없음
+	if slope <= 0 {
+		return f
+	}
+
+	// 마지막 샘플 시점의 회귀 값을 기준으로 100% 도달 시간 계산
+	lastX := history[n-1].t.Sub(origin).Seconds()
+	remaining := (100 - (intercept + slope*lastX)) / slope
+	if remaining < 0 {
+		remaining = 0
+	}
+	f.PredictedFullSeconds = &remaining
+
+	return f
+}
@@ -0,0 +1,276 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+/*
+Package httpstats HTTP 요청 Prometheus 메트릭 및 요청 통계 요약 패키지
+*/
+package httpstats
+
+import (
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const namespace = "unisys_"
+
+// UnmatchedRoute 등록된 라우트와 일치하지 않는 요청의 route 레이블 값
+const UnmatchedRoute = "unmatched"
+
+// Recorder HTTP 요청 메트릭 기록 구조체
+// Prometheus Collector를 구현하며 /sys/stats 요약 정보도 함께 집계
+type Recorder struct {
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	size     *prometheus.HistogramVec
+	inFlight *prometheus.GaugeVec
+
+	started  time.Time
+	hostname string
+	pid      int
+
+	mu           sync.Mutex
+	totalCounts  map[string]int // 상태 코드별 누적 응답 수
+	windowCounts map[string]int // 상태 코드별 현재 1초 구간 응답 수
+	windowSec    int64          // 현재 집계 구간 (Unix 초)
+	totalTime    time.Duration  // 누적 응답 시간
+	totalSize    int64          // 누적 응답 크기
+}
+
+// Data /sys/stats 요청 통계 요약 구조체 (기존 thoas/stats 응답과 같은 형식)
+type Data struct {
+	Pid                    int                `json:"pid"`
+	Hostname               string             `json:"hostname"`
+	UpTime                 string             `json:"uptime"`
+	UpTimeSec              float64            `json:"uptime_sec"`
+	Time                   string             `json:"time"`
+	TimeUnix               int64              `json:"unixtime"`
+	StatusCodeCount        map[string]int     `json:"status_code_count"`
+	TotalStatusCodeCount   map[string]int     `json:"total_status_code_count"`
+	Count                  int                `json:"count"`
+	TotalCount             int                `json:"total_count"`
+	TotalResponseTime      string             `json:"total_response_time"`
+	TotalResponseTimeSec   float64            `json:"total_response_time_sec"`
+	TotalResponseSize      int64              `json:"total_response_size"`
+	AverageResponseSize    int64              `json:"average_response_size"`
+	AverageResponseTime    string             `json:"average_response_time"`
+	AverageResponseTimeSec float64            `json:"average_response_time_sec"`
+	TotalMetricsCounts     map[string]int     `json:"total_metrics_counts"`
+	AverageMetricsTimers   map[string]float64 `json:"average_metrics_timers"`
+}
+
+// NewRecorder HTTP 요청 메트릭 기록 구조체 생성
+//
+// Parameters:
+//   - durationBuckets: 응답 시간 히스토그램 버킷(초)
+//   - sizeBuckets: 응답 크기 히스토그램 버킷(byte)
+//
+// Returns:
+//   - *Recorder: HTTP 요청 메트릭 기록 구조체
+func NewRecorder(durationBuckets, sizeBuckets []float64) *Recorder {
+	hostname, _ := os.Hostname()
+	return &Recorder{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: namespace + "http_requests_total",
+			Help: "HTTP requests completed, by route template, method and status code",
+		}, []string{"route", "method", "code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    namespace + "http_request_duration_seconds",
+			Help:    "HTTP request latency in seconds, by route template and method",
+			Buckets: durationBuckets,
+		}, []string{"route", "method"}),
+		size: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    namespace + "http_response_size_bytes",
+			Help:    "HTTP response body size in bytes, by route template and method",
+			Buckets: sizeBuckets,
+		}, []string{"route", "method"}),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: namespace + "http_requests_in_flight",
+			Help: "HTTP requests currently being served, by route template",
+		}, []string{"route"}),
+		started:      time.Now(),
+		hostname:     hostname,
+		pid:          os.Getpid(),
+		totalCounts:  make(map[string]int),
+		windowCounts: make(map[string]int),
+	}
+}
+
+// Describe Prometheus Collector 인터페이스의 필수 메서드로,
+// 수집할 메트릭의 설명을 채널로 전송
+//
+// Parameters:
+//   - ch: 메트릭 설명 전송 채널
+func (r *Recorder) Describe(ch chan<- *prometheus.Desc) {
+	r.requests.Describe(ch)
+	r.duration.Describe(ch)
+	r.size.Describe(ch)
+	r.inFlight.Describe(ch)
+}
+
+// Collect Prometheus Collector 인터페이스의 필수 메서드로,
+// 메트릭 값을 채널로 전송
+//
+// Parameters:
+//   - ch: 메트릭 전송 채널
+func (r *Recorder) Collect(ch chan<- prometheus.Metric) {
+	r.requests.Collect(ch)
+	r.duration.Collect(ch)
+	r.size.Collect(ch)
+	r.inFlight.Collect(ch)
+}
+
+// Begin 요청 처리 시작 기록
+//
+// Parameters:
+//   - route: 라우트 템플릿 (일치하는 라우트가 없으면 빈 문자열)
+//
+// Returns:
+//   - time.Time: 요청 처리 시작 시각
+func (r *Recorder) Begin(route string) time.Time {
+	r.inFlight.WithLabelValues(routeLabel(route)).Inc()
+	return time.Now()
+}
+
+// End 요청 처리 완료 기록
+//
+// Parameters:
+//   - route: 라우트 템플릿 (Begin과 같은 값)
+//   - method: HTTP 메서드
+//   - code: 응답 상태 코드
+//   - size: 응답 바디 크기(byte)
+//   - start: Begin이 반환한 요청 처리 시작 시각
+func (r *Recorder) End(route, method string, code, size int, start time.Time) {
+	elapsed := time.Since(start)
+	route = routeLabel(route)
+	method = methodLabel(method)
+	if size < 0 {
+		size = 0
+	}
+	codeLabel := strconv.Itoa(code)
+
+	r.inFlight.WithLabelValues(route).Dec()
+	r.requests.WithLabelValues(route, method, codeLabel).Inc()
+	r.duration.WithLabelValues(route, method).Observe(elapsed.Seconds())
+	r.size.WithLabelValues(route, method).Observe(float64(size))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// 1초 구간이 바뀌면 구간 응답 수 초기화
+	if now := time.Now().Unix(); now != r.windowSec {
+		r.windowSec = now
+		r.windowCounts = make(map[string]int)
+	}
+	r.windowCounts[codeLabel]++
+	r.totalCounts[codeLabel]++
+	r.totalTime += elapsed
+	r.totalSize += int64(size)
+}
+
+// Data /sys/stats 요청 통계 요약 정보 생성
+//
+// Returns:
+//   - *Data: 요청 통계 요약 정보
+func (r *Recorder) Data() *Data {
+	now := time.Now()
+	uptime := now.Sub(r.started)
+
+	r.mu.Lock()
+	windowCounts := make(map[string]int, len(r.windowCounts))
+	if r.windowSec == now.Unix() {
+		for code, n := range r.windowCounts {
+			windowCounts[code] = n
+		}
+	}
+	totalCounts := make(map[string]int, len(r.totalCounts))
+	for code, n := range r.totalCounts {
+		totalCounts[code] = n
+	}
+	totalTime := r.totalTime
+	totalSize := r.totalSize
+	r.mu.Unlock()
+
+	count := 0
+	for _, n := range windowCounts {
+		count += n
+	}
+	totalCount := 0
+	for _, n := range totalCounts {
+		totalCount += n
+	}
+
+	var avgTime time.Duration
+	var avgSize int64
+	if totalCount > 0 {
+		avgTime = totalTime / time.Duration(totalCount)
+		avgSize = totalSize / int64(totalCount)
+	}
+
+	return &Data{
+		Pid:                    r.pid,
+		Hostname:               r.hostname,
+		UpTime:                 uptime.String(),
+		UpTimeSec:              uptime.Seconds(),
+		Time:                   now.String(),
+		TimeUnix:               now.Unix(),
+		StatusCodeCount:        windowCounts,
+		TotalStatusCodeCount:   totalCounts,
+		Count:                  count,
+		TotalCount:             totalCount,
+		TotalResponseTime:      totalTime.String(),
+		TotalResponseTimeSec:   totalTime.Seconds(),
+		TotalResponseSize:      totalSize,
+		AverageResponseSize:    avgSize,
+		AverageResponseTime:    avgTime.String(),
+		AverageResponseTimeSec: avgTime.Seconds(),
+		TotalMetricsCounts:     map[string]int{},
+		AverageMetricsTimers:   map[string]float64{},
+	}
+}
+
+// routeLabel route 레이블 값 (일치하는 라우트가 없으면 UnmatchedRoute)
+//
+// Parameters:
+//   - route: 라우트 템플릿
+//
+// Returns:
+//   - string: route 레이블 값
+func routeLabel(route string) string {
+	if route == "" {
+		return UnmatchedRoute
+	}
+	return route
+}
+
+// methodLabel method 레이블 값 (표준 외 메서드는 OTHER로 묶어 레이블 수 제한)
+//
+// Parameters:
+//   - method: HTTP 메서드
+//
+// Returns:
+//   - string: method 레이블 값
+func methodLabel(method string) string {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch,
+		http.MethodDelete, http.MethodOptions, http.MethodConnect, http.MethodTrace:
+		return method
+	}
+	return "OTHER"
+}
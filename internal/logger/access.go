@@ -0,0 +1,77 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/meloncoffee/unisys/config"
+)
+
+// AccessEntry 접근 로그 항목 구조체 (json 형식의 필드 이름은 고정)
+type AccessEntry struct {
+	Time       time.Time     `json:"time"`            // 요청 시작 시간
+	RequestID  string        `json:"request_id"`      // 요청 ID
+	RemoteIP   string        `json:"remote_ip"`       // 클라이언트 IP
+	Method     string        `json:"method"`          // HTTP 메서드
+	Path       string        `json:"path"`            // 요청 경로 (쿼리 포함)
+	Route      string        `json:"route"`           // 라우트 템플릿 (일치하는 라우트가 없으면 빈 문자열)
+	Proto      string        `json:"proto"`           // HTTP 프로토콜 버전
+	Status     int           `json:"status"`          // 응답 상태 코드
+	Bytes      int           `json:"bytes"`           // 응답 바디 크기
+	Duration   time.Duration `json:"-"`               // 처리 시간
+	DurationMs float64       `json:"duration_ms"`     // 처리 시간(ms)
+	Identity   string        `json:"identity"`        // 인증 주체 (미인증 시 anonymous)
+	UserAgent  string        `json:"user_agent"`      // 사용자 에이전트
+	Error      string        `json:"error,omitempty"` // 처리 중 발생한 에러
+}
+
+// LogAccess 접근 로그 기록 (accessLog.format 설정에 따라 text 또는 json 형식)
+// text 형식은 상태 코드에 따라 로그 레벨(5xx:ERROR, 4xx:WARN, 그 외:INFO) 결정
+//
+// Parameters:
+//   - entry: 접근 로그 항목
+func (s *SyncLogger) LogAccess(entry AccessEntry) {
+	if config.Conf.AccessLog.Format == "json" {
+		entry.DurationMs = float64(entry.Duration.Microseconds()) / 1000
+		line, err := json.Marshal(entry)
+		if err != nil {
+			s.zapLogger.Error(fmt.Sprintf("failed to marshal access log: %v", err))
+			return
+		}
+		s.accessWriter.Write(append(line, '\n'))
+		return
+	}
+
+	message := entry.Error
+	if message == "" {
+		message = "Request"
+	}
+	line := fmt.Sprintf("[%d] %s %s (IP: %s, ID: %s, ReqID: %s, Latency: %v, UA: %s, ResSize: %d) %s",
+		entry.Status, entry.Method, entry.Path, entry.RemoteIP, entry.Identity, entry.RequestID,
+		entry.Duration, entry.UserAgent, entry.Bytes, message)
+
+	if entry.Status >= 500 {
+		s.accessLogger.Error(line)
+	} else if entry.Status >= 400 {
+		s.accessLogger.Warn(line)
+	} else {
+		s.accessLogger.Info(line)
+	}
+}
@@ -21,6 +21,7 @@ package logger
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
@@ -40,12 +41,17 @@ type Logger interface {
 	LogDebug(format string, args ...interface{})
 	LogPanic(format string, args ...interface{})
 	LogFatal(format string, args ...interface{})
+	LogAccess(entry AccessEntry)
+	WithRequestID(requestID string) Logger
 }
 
 // SyncLogger 로그 관리 정보 구조체
 type SyncLogger struct {
-	fileLogger *lumberjack.Logger
-	zapLogger  *zap.Logger
+	fileLogger   *lumberjack.Logger
+	zapLogger    *zap.Logger
+	accessFile   *lumberjack.Logger // 별도 접근 로그 파일 (미설정 시 nil)
+	accessLogger *zap.Logger        // text 형식 접근 로거
+	accessWriter io.Writer          // json 형식 접근 로그 출력
 }
 
 var Log Logger = &SyncLogger{}
@@ -55,7 +61,8 @@ func (s *SyncLogger) InitializeLogger() {
 	var cores []zapcore.Core
 
 	// Lumberjack 생성 (자동으로 로그 파일 관리)
-	s.fileLogger = s.newLumberJackLogger(config.LogFilePath)
+	s.fileLogger = s.newLumberJackLogger(config.LogFilePath, config.Conf.Log.MaxLogFileSize,
+		config.Conf.Log.MaxLogFileBackup, config.Conf.Log.MaxLogFileAge, config.Conf.Log.CompBakLogFile)
 
 	// 인코더 설정
 	encoderConfig := zapcore.EncoderConfig{
@@ -101,6 +108,18 @@ func (s *SyncLogger) InitializeLogger() {
 	// 코어로 부터 로거 생성
 	s.zapLogger = zap.New(core, zap.AddCaller(), zap.AddCallerSkip(1),
 		zap.AddStacktrace(zapcore.PanicLevel))
+
+	// 접근 로거 설정 (별도 파일 미설정 시 서버 로그 파일에 기록)
+	s.accessLogger = s.zapLogger
+	s.accessWriter = s.fileLogger
+	if config.Conf.AccessLog.File != "" {
+		s.accessFile = s.newLumberJackLogger(config.Conf.AccessLog.File, config.Conf.AccessLog.MaxLogFileSize,
+			config.Conf.AccessLog.MaxLogFileBackup, config.Conf.AccessLog.MaxLogFileAge,
+			config.Conf.AccessLog.CompBakLogFile)
+		s.accessLogger = zap.New(zapcore.NewCore(consoleEncoder, zapcore.AddSync(s.accessFile), zapcore.DebugLevel),
+			zap.AddCaller(), zap.AddCallerSkip(1))
+		s.accessWriter = s.accessFile
+	}
 }
 
 // FinalizeLogger 프로그램 종료 시 로그 자원 정리
@@ -109,22 +128,44 @@ func (s *SyncLogger) FinalizeLogger() {
 	s.zapLogger.Sync()
 	// 열려 있는 로그 파일을 닫아줌
 	s.fileLogger.Close()
+	if s.accessFile != nil {
+		s.accessLogger.Sync()
+		s.accessFile.Close()
+	}
+}
+
+// WithRequestID 요청 ID를 모든 로그에 포함하는 로거 생성
+//
+// Parameters:
+//   - requestID: 요청 ID
+//
+// Returns:
+//   - Logger: 요청 ID가 포함된 로거 (자원 정리는 원본 로거에서 수행)
+func (s *SyncLogger) WithRequestID(requestID string) Logger {
+	derived := *s
+	derived.zapLogger = s.zapLogger.With(zap.String("request_id", requestID))
+	return &derived
 }
 
 // newLumberJackLogger Lumberjack 생성
 //
 // Parameters:
 //   - logFilePath: 로그 파일 경로
+//   - maxSize: 최대 로그 파일 사이즈(MB)
+//   - maxBackups: 최대 로그 파일 백업 개수
+//   - maxAge: 최대 백업 로그 파일 유지 기간(일)
+//   - compress: 백업 로그 파일 압축 여부
 //
 // Returns:
 //   - *lumberjack.Logger
-func (s *SyncLogger) newLumberJackLogger(logFilePath string) *lumberjack.Logger {
+func (s *SyncLogger) newLumberJackLogger(logFilePath string, maxSize, maxBackups, maxAge int,
+	compress bool) *lumberjack.Logger {
 	return &lumberjack.Logger{
 		Filename:   logFilePath,
-		MaxSize:    config.Conf.Log.MaxLogFileSize,
-		MaxBackups: config.Conf.Log.MaxLogFileBackup,
-		MaxAge:     config.Conf.Log.MaxLogFileAge,
-		Compress:   config.Conf.Log.CompBakLogFile,
+		MaxSize:    maxSize,
+		MaxBackups: maxBackups,
+		MaxAge:     maxAge,
+		Compress:   compress,
 	}
 }
 
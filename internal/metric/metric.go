@@ -20,6 +20,13 @@ Package metric 메트릭 패키지
 package metric
 
 import (
+	"math"
+
+	"github.com/meloncoffee/unisys/config"
+	"github.com/meloncoffee/unisys/internal/anomaly"
+	"github.com/meloncoffee/unisys/internal/certs"
+	"github.com/meloncoffee/unisys/internal/forecast"
+	"github.com/meloncoffee/unisys/internal/ratelimit"
 	"github.com/meloncoffee/unisys/internal/resourcecollecter"
 	"github.com/prometheus/client_golang/prometheus"
 )
@@ -33,6 +40,13 @@ type Metrics struct {
 	DiskUsageRate *prometheus.Desc
 	NetworkInBps  *prometheus.Desc
 	NetworkOutBps *prometheus.Desc
+	AnomalyScore  *prometheus.Desc
+	DiskFullSec   *prometheus.Desc
+	MemFullSec    *prometheus.Desc
+
+	RateLimitRejected *prometheus.Desc
+	RateLimitClients  *prometheus.Desc
+	TLSCertExpiry     *prometheus.Desc
 }
 
 // NewMetrics Metrics 구조체 초기화 및 생성
@@ -68,6 +82,40 @@ func NewMetrics() Metrics {
 			[]string{"interface"},
 			nil,
 		),
+		AnomalyScore: prometheus.NewDesc(
+			namespace+"anomaly_score",
+			"Absolute z-score of the latest sample against its EWMA baseline",
+			[]string{"metric", "interface"},
+			nil,
+		),
+		DiskFullSec: prometheus.NewDesc(
+			namespace+"disk_predicted_full_seconds",
+			"Predicted seconds until the mountpoint is full (+Inf if usage is not growing)",
+			[]string{"mountpoint"},
+			nil,
+		),
+		MemFullSec: prometheus.NewDesc(
+			namespace+"memory_predicted_full_seconds",
+			"Predicted seconds until memory is exhausted (+Inf if usage is not growing)",
+			nil, nil,
+		),
+		RateLimitRejected: prometheus.NewDesc(
+			namespace+"ratelimit_rejected_total",
+			"Requests rejected by the rate limiter (ip, identity) or shed by the in-flight cap (inflight)",
+			[]string{"kind"},
+			nil,
+		),
+		RateLimitClients: prometheus.NewDesc(
+			namespace+"ratelimit_tracked_clients",
+			"Client IPs and identities currently tracked by the rate limiter",
+			nil, nil,
+		),
+		TLSCertExpiry: prometheus.NewDesc(
+			namespace+"tls_certificate_expiry_timestamp_seconds",
+			"Expiry (NotAfter) of the served TLS certificate as a Unix timestamp",
+			[]string{"file", "subject"},
+			nil,
+		),
 	}
 
 	return m
@@ -84,6 +132,12 @@ func (m Metrics) Describe(ch chan<- *prometheus.Desc) {
 	ch <- m.DiskUsageRate
 	ch <- m.NetworkInBps
 	ch <- m.NetworkOutBps
+	ch <- m.AnomalyScore
+	ch <- m.DiskFullSec
+	ch <- m.MemFullSec
+	ch <- m.RateLimitRejected
+	ch <- m.RateLimitClients
+	ch <- m.TLSCertExpiry
 }
 
 // Collect Prometheus Collector 인터페이스의 필수 메서드로,
@@ -147,4 +201,73 @@ func (m Metrics) Collect(ch chan<- prometheus.Metric) {
 			"unknown",
 		)
 	}
+
+	// 이상 점수 메트릭 수집
+	if config.Conf.Anomaly.Enabled {
+		for _, score := range anomaly.GetScores() {
+			ch <- prometheus.MustNewConstMetric(
+				m.AnomalyScore,
+				prometheus.GaugeValue,
+				score.ZScore,
+				score.Metric,
+				score.Interface,
+			)
+		}
+	}
+
+	// 고갈 시점 예측 메트릭 수집
+	if config.Conf.Forecast.Enabled {
+		for _, f := range forecast.GetForecasts() {
+			// 예측 불가(증가 추세 아님, 샘플 부족)일 경우 +Inf
+			fullSec := math.Inf(1)
+			if f.PredictedFullSeconds != nil {
+				fullSec = *f.PredictedFullSeconds
+			}
+
+			switch f.Resource {
+			case "disk":
+				ch <- prometheus.MustNewConstMetric(
+					m.DiskFullSec,
+					prometheus.GaugeValue,
+					fullSec,
+					f.MountPoint,
+				)
+			case "memory":
+				ch <- prometheus.MustNewConstMetric(
+					m.MemFullSec,
+					prometheus.GaugeValue,
+					fullSec,
+				)
+			}
+		}
+	}
+
+	// 속도 제한 메트릭 수집
+	if config.Conf.RateLimit.Enabled {
+		stats := ratelimit.GetStats()
+		for _, kind := range ratelimit.Kinds {
+			ch <- prometheus.MustNewConstMetric(
+				m.RateLimitRejected,
+				prometheus.CounterValue,
+				float64(stats.Rejected[kind]),
+				kind,
+			)
+		}
+		ch <- prometheus.MustNewConstMetric(
+			m.RateLimitClients,
+			prometheus.GaugeValue,
+			float64(stats.Clients),
+		)
+	}
+
+	// TLS 인증서 만료 시간 메트릭 수집
+	for _, info := range certs.GetInfos() {
+		ch <- prometheus.MustNewConstMetric(
+			m.TLSCertExpiry,
+			prometheus.GaugeValue,
+			float64(info.NotAfter.Unix()),
+			info.CertFile,
+			info.Subject,
+		)
+	}
 }
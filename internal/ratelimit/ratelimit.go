@@ -0,0 +1,319 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+/*
+Package ratelimit 요청 속도 및 동시 처리 제한 패키지
+*/
+package ratelimit
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/meloncoffee/unisys/config"
+	"golang.org/x/time/rate"
+)
+
+// 제한 종류
+const (
+	KindIP       = "ip"       // 클라이언트 IP별 속도 제한
+	KindIdentity = "identity" // 인증 주체별 속도 제한
+	KindInFlight = "inflight" // 동시 처리 상한 (load shedding)
+)
+
+// Kinds 제한 종류 목록
+var Kinds = []string{KindIP, KindIdentity, KindInFlight}
+
+// Stats 제한 통계 구조체
+type Stats struct {
+	Rejected map[string]uint64 // 제한 종류별 거부 요청 수
+	Clients  int               // 추적 중인 클라이언트(버킷) 수
+}
+
+var (
+	// 전역 통계 변수 선언
+	rejected = map[string]*atomic.Uint64{
+		KindIP:       new(atomic.Uint64),
+		KindIdentity: new(atomic.Uint64),
+		KindInFlight: new(atomic.Uint64),
+	}
+	// 통계 조회용 현재 제한 관리자
+	current atomic.Pointer[Manager]
+)
+
+// bucket 클라이언트별 토큰 버킷
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// keyedLimiter 키(IP, 인증 주체)별 토큰 버킷 집합
+type keyedLimiter struct {
+	mu        sync.Mutex
+	limit     rate.Limit
+	burst     int
+	idle      time.Duration
+	lastSweep time.Time
+	buckets   map[string]*bucket
+}
+
+// newKeyedLimiter 키별 토큰 버킷 집합 생성
+//
+// Parameters:
+//   - conf: 토큰 버킷 설정
+//   - idle: 미사용 버킷 정리 시간
+//
+// Returns:
+//   - *keyedLimiter: 버킷 집합 (속도가 0이면 nil, 제한 없음)
+func newKeyedLimiter(conf config.RateYaml, idle time.Duration) *keyedLimiter {
+	if conf.Rate <= 0 {
+		return nil
+	}
+	return &keyedLimiter{
+		limit:     rate.Limit(conf.Rate),
+		burst:     conf.Burst,
+		idle:      idle,
+		lastSweep: time.Now(),
+		buckets:   make(map[string]*bucket),
+	}
+}
+
+// allow 키의 버킷에서 토큰 1개 사용
+//
+// Parameters:
+//   - key: 클라이언트 키
+//
+// Returns:
+//   - bool: 허용(true), 거부(false)
+//   - time.Duration: 거부 시 다음 토큰까지 대기 시간
+func (kl *keyedLimiter) allow(key string) (bool, time.Duration) {
+	if kl == nil {
+		return true, 0
+	}
+
+	kl.mu.Lock()
+	defer kl.mu.Unlock()
+
+	now := time.Now()
+	// 미사용 버킷 주기적 정리
+	if now.Sub(kl.lastSweep) > kl.idle {
+		for k, b := range kl.buckets {
+			if now.Sub(b.lastSeen) > kl.idle {
+				delete(kl.buckets, k)
+			}
+		}
+		kl.lastSweep = now
+	}
+
+	b, exists := kl.buckets[key]
+	if !exists {
+		b = &bucket{limiter: rate.NewLimiter(kl.limit, kl.burst)}
+		kl.buckets[key] = b
+	}
+	b.lastSeen = now
+
+	r := b.limiter.ReserveN(now, 1)
+	if delay := r.DelayFrom(now); delay > 0 {
+		r.CancelAt(now)
+		return false, delay
+	}
+	return true, 0
+}
+
+// size 추적 중인 버킷 수
+//
+// Returns:
+//   - int: 버킷 수
+func (kl *keyedLimiter) size() int {
+	if kl == nil {
+		return 0
+	}
+	kl.mu.Lock()
+	defer kl.mu.Unlock()
+	return len(kl.buckets)
+}
+
+// limits 라우트에 적용되는 제한 묶음
+type limits struct {
+	ip       *keyedLimiter
+	identity *keyedLimiter
+	slots    chan struct{} // 동시 처리 슬롯 (nil이면 제한 없음)
+}
+
+// Manager 전역 및 라우트별 제한 관리 구조체
+type Manager struct {
+	global limits
+	routes map[string]*limits
+}
+
+// NewManager 설정 파일의 rateLimit 설정으로 제한 관리자 생성
+// 라우트별 설정의 경로는 canonical로 변환하여 저장하며, 변환 결과가 같은 설정이 여럿이면
+// 변환 전후 경로가 같은 설정을 사용
+//
+// Parameters:
+//   - canonical: 라우트 경로 변환 함수 (별칭 경로를 대표 경로로 변환)
+//
+// Returns:
+//   - *Manager
+func NewManager(canonical func(route string) string) *Manager {
+	conf := config.Conf.RateLimit
+	idle := time.Duration(conf.IdleTimeout) * time.Second
+
+	m := &Manager{
+		global: limits{
+			ip:       newKeyedLimiter(conf.PerIP, idle),
+			identity: newKeyedLimiter(conf.PerIdentity, idle),
+			slots:    make(chan struct{}, conf.MaxInFlight),
+		},
+		routes: make(map[string]*limits),
+	}
+
+	for route, override := range conf.Routes {
+		key := canonical(route)
+		if _, ok := conf.Routes[key]; ok && key != route {
+			continue
+		}
+		l := &limits{ip: m.global.ip, identity: m.global.identity, slots: m.global.slots}
+		if override.PerIP != nil {
+			l.ip = newKeyedLimiter(*override.PerIP, idle)
+		}
+		if override.PerIdentity != nil {
+			l.identity = newKeyedLimiter(*override.PerIdentity, idle)
+		}
+		switch {
+		case override.MaxInFlight > 0:
+			l.slots = make(chan struct{}, override.MaxInFlight)
+		case override.MaxInFlight < 0:
+			l.slots = nil
+		}
+		m.routes[key] = l
+	}
+
+	current.Store(m)
+	return m
+}
+
+// limitsFor 라우트에 적용되는 제한 묶음 조회
+//
+// Parameters:
+//   - route: 라우트 경로 (NewManager의 canonical로 변환한 경로)
+//
+// Returns:
+//   - *limits: 제한 묶음
+func (m *Manager) limitsFor(route string) *limits {
+	if l, ok := m.routes[route]; ok {
+		return l
+	}
+	return &m.global
+}
+
+// AllowIP 클라이언트 IP 속도 제한 확인
+//
+// Parameters:
+//   - route: 라우트 경로
+//   - ip: 클라이언트 IP
+//
+// Returns:
+//   - bool: 허용(true), 거부(false)
+//   - time.Duration: 거부 시 재시도 대기 시간
+func (m *Manager) AllowIP(route, ip string) (bool, time.Duration) {
+	ok, wait := m.limitsFor(route).ip.allow(ip)
+	if !ok {
+		rejected[KindIP].Add(1)
+	}
+	return ok, wait
+}
+
+// AllowIdentity 인증 주체 속도 제한 확인
+//
+// Parameters:
+//   - route: 라우트 경로
+//   - identity: 인증 주체 키
+//
+// Returns:
+//   - bool: 허용(true), 거부(false)
+//   - time.Duration: 거부 시 재시도 대기 시간
+func (m *Manager) AllowIdentity(route, identity string) (bool, time.Duration) {
+	ok, wait := m.limitsFor(route).identity.allow(identity)
+	if !ok {
+		rejected[KindIdentity].Add(1)
+	}
+	return ok, wait
+}
+
+// Acquire 동시 처리 슬롯 획득 (대기하지 않음)
+//
+// Parameters:
+//   - route: 라우트 경로
+//
+// Returns:
+//   - func(): 슬롯 반환 함수 (획득 실패 시 nil)
+//   - bool: 획득(true), 상한 초과(false)
+func (m *Manager) Acquire(route string) (func(), bool) {
+	slots := m.limitsFor(route).slots
+	if slots == nil {
+		return func() {}, true
+	}
+
+	select {
+	case slots <- struct{}{}:
+		return func() { <-slots }, true
+	default:
+		rejected[KindInFlight].Add(1)
+		return nil, false
+	}
+}
+
+// clients 추적 중인 클라이언트(버킷) 수
+//
+// Returns:
+//   - int: 버킷 수
+func (m *Manager) clients() int {
+	all := []*keyedLimiter{m.global.ip, m.global.identity}
+	for _, l := range m.routes {
+		all = append(all, l.ip, l.identity)
+	}
+
+	// 라우트가 전역 버킷 집합을 공유하는 경우 중복 집계 방지
+	seen := make(map[*keyedLimiter]struct{})
+	count := 0
+	for _, kl := range all {
+		if _, dup := seen[kl]; dup || kl == nil {
+			continue
+		}
+		seen[kl] = struct{}{}
+		count += kl.size()
+	}
+	return count
+}
+
+// GetStats 제한 통계 조회
+//
+// Returns:
+//   - Stats: 제한 통계
+func GetStats() Stats {
+	stats := Stats{
+		Rejected: make(map[string]uint64, len(rejected)),
+	}
+	for kind, counter := range rejected {
+		stats.Rejected[kind] = counter.Load()
+	}
+	if m := current.Load(); m != nil {
+		stats.Clients = m.clients()
+	}
+	return stats
+}
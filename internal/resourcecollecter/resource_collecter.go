@@ -22,8 +22,12 @@ package resourcecollecter
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/meloncoffee/unisys/config"
+	"github.com/meloncoffee/unisys/internal/anomaly"
+	"github.com/meloncoffee/unisys/internal/forecast"
 	"github.com/meloncoffee/unisys/internal/logger"
 	"github.com/meloncoffee/unisys/pkg/util/goroutine"
 	"github.com/meloncoffee/unisys/pkg/util/resource"
@@ -31,9 +35,11 @@ import (
 
 // Resource 리소스 정보 구조체
 type Resource struct {
+	CollectedAt    time.Time
 	CPUUsageRate   float64
 	MemUsageRate   float64
 	DiskUsageRate  float64
+	Disks          []resource.DiskStat
 	NetworkTraffic []resource.NetworkTraffic
 }
 
@@ -43,6 +49,18 @@ var (
 	GlobalResMutex sync.RWMutex
 )
 
+// Subscription 리소스 수집 알림 구독 정보 구조체
+type Subscription struct {
+	ch      chan Resource
+	dropped atomic.Uint64
+}
+
+var (
+	// 리소스 수집 알림 구독자 목록
+	subscribers = make(map[*Subscription]struct{})
+	subMutex    sync.Mutex
+)
+
 // SetGlobalResource 전역 리소스 구조체 정보 업데이트
 //
 // Parameters:
@@ -51,9 +69,11 @@ func SetGlobalResource(r *Resource) {
 	GlobalResMutex.Lock()
 	defer GlobalResMutex.Unlock()
 	GlobalResource = Resource{
+		CollectedAt:    r.CollectedAt,
 		CPUUsageRate:   r.CPUUsageRate,
 		MemUsageRate:   r.MemUsageRate,
 		DiskUsageRate:  r.DiskUsageRate,
+		Disks:          append([]resource.DiskStat{}, r.Disks...),
 		NetworkTraffic: append([]resource.NetworkTraffic{}, r.NetworkTraffic...),
 	}
 }
@@ -66,15 +86,99 @@ func GetGlobalResource() Resource {
 	GlobalResMutex.RLock()
 	defer GlobalResMutex.RUnlock()
 	return Resource{
+		CollectedAt:    GlobalResource.CollectedAt,
 		CPUUsageRate:   GlobalResource.CPUUsageRate,
 		MemUsageRate:   GlobalResource.MemUsageRate,
 		DiskUsageRate:  GlobalResource.DiskUsageRate,
+		Disks:          append([]resource.DiskStat{}, GlobalResource.Disks...),
 		NetworkTraffic: append([]resource.NetworkTraffic{}, GlobalResource.NetworkTraffic...),
 	}
 }
 
+// Subscribe 리소스 수집 알림 구독
+// 구독자가 버퍼를 비우지 못하면 가장 오래된 샘플부터 버려짐
+//
+// Parameters:
+//   - bufSize: 알림 채널 버퍼 크기
+//
+// Returns:
+//   - *Subscription: 구독 정보 (사용 후 Unsubscribe 호출 필요)
+func Subscribe(bufSize int) *Subscription {
+	if bufSize < 1 {
+		bufSize = 1
+	}
+
+	sub := &Subscription{ch: make(chan Resource, bufSize)}
+
+	subMutex.Lock()
+	defer subMutex.Unlock()
+	subscribers[sub] = struct{}{}
+
+	return sub
+}
+
+// Unsubscribe 리소스 수집 알림 구독 해제 (알림 채널이 닫힘)
+//
+// Parameters:
+//   - sub: 구독 정보
+func Unsubscribe(sub *Subscription) {
+	subMutex.Lock()
+	defer subMutex.Unlock()
+
+	if _, exists := subscribers[sub]; exists {
+		delete(subscribers, sub)
+		close(sub.ch)
+	}
+}
+
+// C 리소스 수집 알림 채널 반환
+//
+// Returns:
+//   - <-chan Resource: 알림 채널
+func (s *Subscription) C() <-chan Resource {
+	return s.ch
+}
+
+// Dropped 마지막 호출 이후 버퍼 초과로 버려진 샘플 수 반환
+//
+// Returns:
+//   - uint64: 버려진 샘플 수
+func (s *Subscription) Dropped() uint64 {
+	return s.dropped.Swap(0)
+}
+
+// publishResource 모든 구독자에게 수집된 리소스 정보 전달
+//
+// Parameters:
+//   - r: 리소스 정보 구조체
+func publishResource(r Resource) {
+	subMutex.Lock()
+	defer subMutex.Unlock()
+
+	for sub := range subscribers {
+		select {
+		case sub.ch <- r:
+		default:
+			// 버퍼가 가득 찬 경우 가장 오래된 샘플을 버리고 전달
+			select {
+			case <-sub.ch:
+				sub.dropped.Add(1)
+			default:
+			}
+			select {
+			case sub.ch <- r:
+			default:
+				sub.dropped.Add(1)
+			}
+		}
+	}
+}
+
 // ResourceCollecter 리소스 수집 구조체
-type ResourceCollecter struct{}
+type ResourceCollecter struct {
+	// 고갈 시점 예측 이력이 존재하는 마운트 경로 목록
+	forecastMounts map[string]struct{}
+}
 
 // CollectResource 리소스 수집
 //
@@ -123,6 +227,17 @@ func (rc *ResourceCollecter) CollectResource(ctx context.Context) {
 			}
 		}()
 
+		// 마운트 경로별 디스크 상태 정보 획득
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var err error
+			res.Disks, err = rc.getDiskStats()
+			if err != nil {
+				logger.Log.LogWarn("failed to get disk stats: %v", err)
+			}
+		}()
+
 		// 네트워크 트래픽량 획득
 		wg.Add(1)
 		go func() {
@@ -136,9 +251,22 @@ func (rc *ResourceCollecter) CollectResource(ctx context.Context) {
 
 		// 고루틴 종료 대기
 		wg.Wait()
+		res.CollectedAt = time.Now()
 
 		// 글로벌 리소스 구조체에 정보 업데이트
 		SetGlobalResource(&res)
+		// 구독자에게 수집된 리소스 정보 전달
+		publishResource(GetGlobalResource())
+
+		// 수집된 리소스를 베이스라인과 비교하여 이상 탐지
+		if config.Conf.Anomaly.Enabled {
+			rc.detectAnomaly(&res)
+		}
+
+		// 디스크/메모리 사용률 이력을 고갈 시점 예측에 반영
+		if config.Conf.Forecast.Enabled {
+			rc.observeForecast(&res)
+		}
 
 		// if config.RunConf.DebugMode {
 		// 	logger.Log.LogDebug("CPU Usage Rate: %.2f%%", res.CPUUsageRate)
@@ -209,6 +337,30 @@ func (rc *ResourceCollecter) getDiskUsageRate() (float64, error) {
 	return resource.CalculateDiskRate(diskStat), nil
 }
 
+// getDiskStats 마운트 경로별 디스크 상태 정보 획득
+//
+// Returns:
+//   - []resource.DiskStat: 디스크 상태 정보 목록
+//   - error: 성공(nil), 실패(error)
+func (rc *ResourceCollecter) getDiskStats() ([]resource.DiskStat, error) {
+	// 수집 대상 마운트 경로 획득
+	mountPoints, err := resource.GetMountPoints()
+	if err != nil {
+		return nil, err
+	}
+
+	diskStats := make([]resource.DiskStat, 0, len(mountPoints))
+	for _, mountPoint := range mountPoints {
+		diskStat, err := resource.GetDiskStat(mountPoint)
+		if err != nil || diskStat.Total == 0 {
+			continue
+		}
+		diskStats = append(diskStats, diskStat)
+	}
+
+	return diskStats, nil
+}
+
 // getNetworkTraffic 네트워크 트래픽량 획득
 //
 // Returns:
@@ -239,3 +391,60 @@ func (rc *ResourceCollecter) getNetworkTraffic() ([]resource.NetworkTraffic, err
 
 	return trafficList, nil
 }
+
+// anomalyMaxMissed 이상 탐지 베이스라인을 제거하기 전 허용되는 연속 미수집 주기 수
+const anomalyMaxMissed = 5
+
+// detectAnomaly 수집된 리소스의 이상 점수 계산
+//
+// Parameters:
+//   - res: 리소스 정보 구조체
+func (rc *ResourceCollecter) detectAnomaly(res *Resource) {
+	now := time.Now()
+
+	samples := map[anomaly.Key]float64{
+		{Metric: "cpu_usage_rate"}:    res.CPUUsageRate,
+		{Metric: "memory_usage_rate"}: res.MemUsageRate,
+		{Metric: "disk_usage_rate"}:   res.DiskUsageRate,
+	}
+	for _, traffic := range res.NetworkTraffic {
+		samples[anomaly.Key{Metric: "network_inbound_bps", Interface: traffic.Interface}] = traffic.InboundBps
+		samples[anomaly.Key{Metric: "network_outbound_bps", Interface: traffic.Interface}] = traffic.OutboundBps
+	}
+
+	for key, value := range samples {
+		score := anomaly.Observe(key, value, now)
+		if score.Anomalous {
+			logger.Log.LogWarn("anomaly detected (metric:%s, interface:%s, value:%.2f, mean:%.2f, z-score:%.2f)",
+				key.Metric, key.Interface, score.Value, score.Mean, score.ZScore)
+		}
+	}
+
+	// 사라진 네트워크 인터페이스의 베이스라인 제거
+	anomaly.Sweep(anomalyMaxMissed)
+}
+
+// observeForecast 디스크/메모리 사용률을 고갈 시점 예측 이력에 추가
+//
+// Parameters:
+//   - res: 리소스 정보 구조체
+func (rc *ResourceCollecter) observeForecast(res *Resource) {
+	now := time.Now()
+
+	forecast.Observe(forecast.Key{Resource: "memory"}, res.MemUsageRate, now)
+
+	mounts := make(map[string]struct{}, len(res.Disks))
+	for _, disk := range res.Disks {
+		mounts[disk.MountPoint] = struct{}{}
+		forecast.Observe(forecast.Key{Resource: "disk", MountPoint: disk.MountPoint},
+			resource.CalculateDiskRate(disk), now)
+	}
+
+	// 더 이상 마운트되어 있지 않은 경로의 이력 제거
+	for mountPoint := range rc.forecastMounts {
+		if _, ok := mounts[mountPoint]; !ok {
+			forecast.Remove(forecast.Key{Resource: "disk", MountPoint: mountPoint})
+		}
+	}
+	rc.forecastMounts = mounts
+}
@@ -0,0 +1,257 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package server
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/meloncoffee/unisys/config"
+	"github.com/pires/go-proxyproto"
+)
+
+// ErrCodeIPDenied 클라이언트 IP 접근 거부 에러 코드
+const ErrCodeIPDenied = "ip_denied"
+
+// ipRule IP 허용/거부 규칙
+type ipRule struct {
+	allow []netip.Prefix
+	deny  []netip.Prefix
+}
+
+// ipFilter 전역 및 라우트별 IP 접근 규칙
+type ipFilter struct {
+	global ipRule
+	routes map[string]ipRule
+}
+
+// accessFilter 클라이언트 IP 접근 규칙 (규칙이 없으면 nil)
+var accessFilter *ipFilter
+
+// parsePrefixes IP 또는 CIDR 문자열 목록을 파싱
+//
+// Parameters:
+//   - entries: IP 또는 CIDR 목록
+//
+// Returns:
+//   - []netip.Prefix: 파싱된 대역 목록
+//   - error: 성공(nil), 실패(error)
+func parsePrefixes(entries []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(entries))
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if strings.Contains(entry, "/") {
+			prefix, err := netip.ParsePrefix(entry)
+			if err != nil {
+				return nil, fmt.Errorf("invalid CIDR %q", entry)
+			}
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+
+		addr, err := netip.ParseAddr(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid IP %q", entry)
+		}
+		addr = addr.Unmap()
+		prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return prefixes, nil
+}
+
+// newIPRule 설정으로부터 IP 규칙 생성
+//
+// Parameters:
+//   - allow: 허용 IP/CIDR 목록
+//   - deny: 거부 IP/CIDR 목록
+//
+// Returns:
+//   - ipRule: IP 규칙
+//   - error: 성공(nil), 실패(error)
+func newIPRule(allow, deny []string) (ipRule, error) {
+	var rule ipRule
+	var err error
+	if rule.allow, err = parsePrefixes(allow); err != nil {
+		return rule, fmt.Errorf("allow: %v", err)
+	}
+	if rule.deny, err = parsePrefixes(deny); err != nil {
+		return rule, fmt.Errorf("deny: %v", err)
+	}
+	return rule, nil
+}
+
+// newIPFilter 설정 파일의 access 설정으로 IP 접근 규칙 생성
+//
+// Returns:
+//   - *ipFilter: IP 접근 규칙 (규칙이 없으면 nil)
+//   - error: 성공(nil), 실패(error)
+func newIPFilter() (*ipFilter, error) {
+	conf := config.Conf.Access
+	if len(conf.Allow) == 0 && len(conf.Deny) == 0 && len(conf.Routes) == 0 {
+		return nil, nil
+	}
+
+	global, err := newIPRule(conf.Allow, conf.Deny)
+	if err != nil {
+		return nil, fmt.Errorf("access %v", err)
+	}
+
+	// 별칭 경로로 지정한 규칙도 대응하는 /api/v1 라우트 규칙으로 저장
+	filter := &ipFilter{global: global, routes: make(map[string]ipRule)}
+	for route, ruleConf := range conf.Routes {
+		rule, err := newIPRule(ruleConf.Allow, ruleConf.Deny)
+		if err != nil {
+			return nil, fmt.Errorf("access route %s %v", route, err)
+		}
+		canonical := canonicalRoute(route)
+		if _, ok := filter.routes[canonical]; ok {
+			return nil, fmt.Errorf("access route %s duplicates the rule for %s", route, canonical)
+		}
+		filter.routes[canonical] = rule
+	}
+	return filter, nil
+}
+
+// permits IP가 규칙을 통과하는지 확인 (거부 목록 우선, 허용 목록이 있으면 일치해야 통과)
+//
+// Parameters:
+//   - addr: 클라이언트 IP
+//
+// Returns:
+//   - bool: 허용(true), 거부(false)
+func (r ipRule) permits(addr netip.Addr) bool {
+	for _, prefix := range r.deny {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	if len(r.allow) == 0 {
+		return true
+	}
+	for _, prefix := range r.allow {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// permits 전역 규칙과 라우트 규칙을 모두 통과하는지 확인
+// 별칭 경로는 대응하는 /api/v1 라우트 규칙을 적용
+//
+// Parameters:
+//   - route: 라우트 경로
+//   - clientIP: 클라이언트 IP 문자열
+//
+// Returns:
+//   - bool: 허용(true), 거부(false)
+func (f *ipFilter) permits(route, clientIP string) bool {
+	addr, err := netip.ParseAddr(clientIP)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+
+	if !f.global.permits(addr) {
+		return false
+	}
+	if rule, ok := f.routes[canonicalRoute(route)]; ok && !rule.permits(addr) {
+		return false
+	}
+	return true
+}
+
+// accessMiddleware 클라이언트 IP 접근 제어 미들웨어
+//
+// Returns:
+//   - gin.HandlerFunc: gin 미들웨어
+func (s *Server) accessMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 유닉스 소켓 요청은 클라이언트 IP가 없으며 소켓 파일 권한으로 접근 제어
+		if accessFilter == nil || isUnixRequest(c.Request) {
+			c.Next()
+			return
+		}
+
+		clientIP := c.ClientIP()
+		if !accessFilter.permits(c.FullPath(), clientIP) {
+			requestLogger(c).LogWarn("access denied by ip rules (IP: %s, route: %s %s)",
+				clientIP, c.Request.Method, c.Request.URL.Path)
+			abortWithError(c, http.StatusForbidden, ErrCodeIPDenied, "client address is not allowed")
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// trustedProxies gin에 설정할 신뢰 프록시 목록
+//
+// Returns:
+//   - []string: 신뢰 프록시 IP/CIDR 목록 (설정이 없으면 nil, 프록시 헤더 무시)
+func trustedProxies() []string {
+	if len(config.Conf.Server.TrustedProxies) == 0 {
+		return nil
+	}
+	return config.Conf.Server.TrustedProxies
+}
+
+// wrapProxyProtocol 신뢰 프록시로부터 PROXY protocol 헤더를 읽도록 리스너 감싸기
+// 신뢰 프록시가 아닌 주소의 연결은 헤더를 읽지 않고 그대로 처리
+//
+// Parameters:
+//   - ln: 원본 리스너
+//
+// Returns:
+//   - net.Listener: PROXY protocol 리스너
+//   - error: 성공(nil), 실패(error)
+func wrapProxyProtocol(ln net.Listener) (net.Listener, error) {
+	trusted, err := parsePrefixes(config.Conf.Server.TrustedProxies)
+	if err != nil {
+		return nil, fmt.Errorf("trustedProxies: %v", err)
+	}
+	if len(trusted) == 0 {
+		return nil, fmt.Errorf("proxyProtocol requires trustedProxies")
+	}
+
+	return &proxyproto.Listener{
+		Listener: ln,
+		Policy: func(upstream net.Addr) (proxyproto.Policy, error) {
+			tcpAddr, ok := upstream.(*net.TCPAddr)
+			if !ok {
+				return proxyproto.SKIP, nil
+			}
+			addr, ok := netip.AddrFromSlice(tcpAddr.IP)
+			if !ok {
+				return proxyproto.SKIP, nil
+			}
+			addr = addr.Unmap()
+			for _, prefix := range trusted {
+				if prefix.Contains(addr) {
+					return proxyproto.USE, nil
+				}
+			}
+			return proxyproto.SKIP, nil
+		},
+		ReadHeaderTimeout: time.Duration(config.Conf.Server.ProxyHeaderTimeout) * time.Second,
+	}, nil
+}
@@ -0,0 +1,211 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/meloncoffee/unisys/config"
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// acmeHTTPTimeout ACME 서버 요청 타임아웃
+const acmeHTTPTimeout = 30 * time.Second
+
+// acmeHosts 인증서를 발급받을 도메인 목록 (host + hosts, 중복 제거)
+//
+// Returns:
+//   - []string: 도메인 목록
+func acmeHosts() []string {
+	conf := config.Conf.Server.AutoTLS
+	seen := make(map[string]struct{})
+	hosts := make([]string, 0, len(conf.Hosts)+1)
+	for _, host := range append([]string{conf.Host}, conf.Hosts...) {
+		host = strings.ToLower(strings.TrimSpace(host))
+		if _, dup := seen[host]; dup || host == "" {
+			continue
+		}
+		seen[host] = struct{}{}
+		hosts = append(hosts, host)
+	}
+	return hosts
+}
+
+// decodeEABKey External Account Binding HMAC 키 디코딩 (base64url, 패딩 유무 무관)
+//
+// Parameters:
+//   - key: base64url 인코딩된 키
+//
+// Returns:
+//   - []byte: HMAC 키
+//   - error: 성공(nil), 실패(error)
+func decodeEABKey(key string) ([]byte, error) {
+	key = strings.TrimRight(strings.TrimSpace(key), "=")
+	// CA에 따라 표준 base64로 안내하는 경우도 있으므로 URL-safe 문자로 변환
+	key = strings.NewReplacer("+", "-", "/", "_").Replace(key)
+	return base64.RawURLEncoding.DecodeString(key)
+}
+
+// newACMEManager 설정 파일의 autoTLS 설정으로 ACME 인증서 관리자 생성
+//
+// Returns:
+//   - *autocert.Manager: ACME 인증서 관리자
+//   - error: 성공(nil), 실패(error)
+func newACMEManager() (*autocert.Manager, error) {
+	conf := config.Conf.Server.AutoTLS
+	hosts := acmeHosts()
+	if len(hosts) == 0 {
+		return nil, fmt.Errorf("host or hosts is required")
+	}
+	if conf.CertPath == "" {
+		return nil, fmt.Errorf("certPath is required")
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if conf.CAFile != "" {
+		pem, err := os.ReadFile(conf.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read caFile: %v", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in caFile (%s)", conf.CAFile)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+
+	m := &autocert.Manager{
+		Prompt:     autocert.AcceptTOS,
+		HostPolicy: autocert.HostWhitelist(hosts...),
+		Cache:      autocert.DirCache(conf.CertPath),
+		Email:      conf.Email,
+		Client: &acme.Client{
+			DirectoryURL: conf.DirectoryURL,
+			HTTPClient:   &http.Client{Transport: transport, Timeout: acmeHTTPTimeout},
+		},
+	}
+
+	// External Account Binding 설정 (키 ID와 HMAC 키 모두 필요)
+	if conf.EAB.KeyID != "" || conf.EAB.HMACKey != "" {
+		if conf.EAB.KeyID == "" || conf.EAB.HMACKey == "" {
+			return nil, fmt.Errorf("eab requires both keyID and hmacKey")
+		}
+		key, err := decodeEABKey(conf.EAB.HMACKey)
+		if err != nil {
+			return nil, fmt.Errorf("eab hmacKey is not valid base64url: %v", err)
+		}
+		m.ExternalAccountBinding = &acme.ExternalAccountBinding{KID: conf.EAB.KeyID, Key: key}
+	}
+
+	return m, nil
+}
+
+// httpsPort HTTPS 리다이렉트 대상 포트 (443 TLS 리스너 우선, 없으면 첫 번째 TCP TLS 리스너 포트)
+//
+// Parameters:
+//   - listeners: 리스너 목록
+//
+// Returns:
+//   - int: 포트 (TCP TLS 리스너가 없으면 0)
+func httpsPort(listeners []*listener) int {
+	port := 0
+	for _, l := range listeners {
+		if !l.tls || l.network != "tcp" {
+			continue
+		}
+		_, portName, err := net.SplitHostPort(l.address)
+		if err != nil {
+			continue
+		}
+		p, err := net.LookupPort("tcp", portName)
+		if err != nil {
+			continue
+		}
+		if p == 443 {
+			return p
+		}
+		if port == 0 {
+			port = p
+		}
+	}
+	return port
+}
+
+// httpsRedirectHandler HTTPS 리다이렉트 핸들러 (요청 Host의 포트는 제거된 상태로 호출)
+//
+// Parameters:
+//   - port: HTTPS 포트
+//
+// Returns:
+//   - http.Handler: 리다이렉트 핸들러
+func httpsRedirectHandler(port int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, "Use HTTPS", http.StatusBadRequest)
+			return
+		}
+		target := net.JoinHostPort(r.Host, strconv.Itoa(port))
+		if port == 443 {
+			target = strings.TrimSuffix(target, ":443")
+		}
+		http.Redirect(w, r, "https://"+target+r.URL.RequestURI(), http.StatusFound)
+	})
+}
+
+// newACMEHTTPServer HTTP-01 챌린지 응답 및 HTTPS 리다이렉트 서버 생성
+//
+// Parameters:
+//   - m: ACME 인증서 관리자
+//   - redirectPort: HTTPS 리다이렉트 대상 포트 (0이면 리다이렉트하지 않음)
+//
+// Returns:
+//   - *http.Server: HTTP 서버
+func newACMEHTTPServer(m *autocert.Manager, redirectPort int) *http.Server {
+	// 챌린지 외 요청은 TLS 리스너 포트로 HTTPS 리다이렉트하거나 404 응답
+	fallback := http.NotFoundHandler()
+	if config.Conf.Server.AutoTLS.HTTPRedirect && redirectPort > 0 {
+		fallback = httpsRedirectHandler(redirectPort)
+	}
+
+	// autocert는 Host 헤더 전체를 HostPolicy로 검사하므로 80 외 포트 사용 시를 위해 포트 제거
+	handler := m.HTTPHandler(fallback)
+	stripPort := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if host, _, err := net.SplitHostPort(r.Host); err == nil {
+			r.Host = host
+		}
+		handler.ServeHTTP(w, r)
+	})
+
+	return &http.Server{
+		Addr:              ":" + strconv.Itoa(config.Conf.Server.AutoTLS.HTTPPort),
+		Handler:           stripPort,
+		ReadHeaderTimeout: 5 * time.Second,
+		ReadTimeout:       10 * time.Second,
+		WriteTimeout:      10 * time.Second,
+		MaxHeaderBytes:    config.Conf.Server.MaxHeaderBytes,
+	}
+}
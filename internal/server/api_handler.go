@@ -0,0 +1,152 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/meloncoffee/unisys/internal/resourcecollecter"
+	"github.com/meloncoffee/unisys/pkg/util/resource"
+)
+
+// CPUResponse CPU 리소스 응답 구조체
+type CPUResponse struct {
+	UsageRate float64 `json:"usageRate"` // CPU 사용률 (%)
+}
+
+// MemoryResponse 메모리 리소스 응답 구조체
+type MemoryResponse struct {
+	UsageRate float64 `json:"usageRate"` // 메모리 사용률 (%)
+}
+
+// DiskResponse 마운트 경로별 디스크 리소스 응답 구조체
+type DiskResponse struct {
+	MountPoint string  `json:"mountPoint"` // 마운트 경로
+	TotalBytes uint64  `json:"totalBytes"` // 총 디스크 크기 (byte)
+	FreeBytes  uint64  `json:"freeBytes"`  // 사용 가능한 공간 (byte)
+	UsedBytes  uint64  `json:"usedBytes"`  // 사용된 공간 (byte)
+	UsageRate  float64 `json:"usageRate"`  // 디스크 사용률 (%)
+}
+
+// DisksResponse 디스크 리소스 응답 구조체
+type DisksResponse struct {
+	RootUsageRate float64        `json:"rootUsageRate"` // 루트(/) 디스크 사용률 (%)
+	Disks         []DiskResponse `json:"disks"`         // 마운트 경로별 디스크 정보
+}
+
+// InterfaceResponse 인터페이스별 네트워크 트래픽 응답 구조체
+type InterfaceResponse struct {
+	Interface   string  `json:"interface"`   // 인터페이스명
+	InboundBps  float64 `json:"inboundBps"`  // 인바운드 트래픽량 (bps)
+	OutboundBps float64 `json:"outboundBps"` // 아웃바운드 트래픽량 (bps)
+}
+
+// NetworkResponse 네트워크 리소스 응답 구조체
+type NetworkResponse struct {
+	Interfaces []InterfaceResponse `json:"interfaces"` // 인터페이스별 트래픽 정보
+}
+
+// ResourcesResponse 전체 리소스 스냅샷 응답 구조체
+type ResourcesResponse struct {
+	CollectedAt time.Time       `json:"collectedAt"` // 리소스 수집 시간
+	CPU         CPUResponse     `json:"cpu"`
+	Memory      MemoryResponse  `json:"memory"`
+	Disks       DisksResponse   `json:"disks"`
+	Network     NetworkResponse `json:"network"`
+}
+
+// newResourcesResponse 수집된 리소스 정보를 응답 구조체로 변환
+//
+// Parameters:
+//   - res: 리소스 정보 구조체
+//
+// Returns:
+//   - ResourcesResponse: 전체 리소스 스냅샷 응답 구조체
+func newResourcesResponse(res resourcecollecter.Resource) ResourcesResponse {
+	disks := make([]DiskResponse, 0, len(res.Disks))
+	for _, disk := range res.Disks {
+		disks = append(disks, DiskResponse{
+			MountPoint: disk.MountPoint,
+			TotalBytes: disk.Total,
+			FreeBytes:  disk.Free,
+			UsedBytes:  disk.Used,
+			UsageRate:  resource.CalculateDiskRate(disk),
+		})
+	}
+
+	interfaces := make([]InterfaceResponse, 0, len(res.NetworkTraffic))
+	for _, traffic := range res.NetworkTraffic {
+		interfaces = append(interfaces, InterfaceResponse{
+			Interface:   traffic.Interface,
+			InboundBps:  traffic.InboundBps,
+			OutboundBps: traffic.OutboundBps,
+		})
+	}
+
+	return ResourcesResponse{
+		CollectedAt: res.CollectedAt,
+		CPU:         CPUResponse{UsageRate: res.CPUUsageRate},
+		Memory:      MemoryResponse{UsageRate: res.MemUsageRate},
+		Disks: DisksResponse{
+			RootUsageRate: res.DiskUsageRate,
+			Disks:         disks,
+		},
+		Network: NetworkResponse{Interfaces: interfaces},
+	}
+}
+
+// resourcesHandler 전체 리소스 스냅샷 핸들러
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+func resourcesHandler(c *gin.Context) {
+	c.JSON(http.StatusOK, newResourcesResponse(resourcecollecter.GetGlobalResource()))
+}
+
+// cpuResourceHandler CPU 리소스 핸들러
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+func cpuResourceHandler(c *gin.Context) {
+	c.JSON(http.StatusOK, newResourcesResponse(resourcecollecter.GetGlobalResource()).CPU)
+}
+
+// memoryResourceHandler 메모리 리소스 핸들러
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+func memoryResourceHandler(c *gin.Context) {
+	c.JSON(http.StatusOK, newResourcesResponse(resourcecollecter.GetGlobalResource()).Memory)
+}
+
+// disksResourceHandler 디스크 리소스 핸들러
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+func disksResourceHandler(c *gin.Context) {
+	c.JSON(http.StatusOK, newResourcesResponse(resourcecollecter.GetGlobalResource()).Disks)
+}
+
+// networkResourceHandler 네트워크 리소스 핸들러
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+func networkResourceHandler(c *gin.Context) {
+	c.JSON(http.StatusOK, newResourcesResponse(resourcecollecter.GetGlobalResource()).Network)
+}
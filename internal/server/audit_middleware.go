@@ -0,0 +1,146 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/meloncoffee/unisys/config"
+	"github.com/meloncoffee/unisys/internal/audit"
+	"golang.org/x/time/rate"
+)
+
+// maxAuditBodySize 감사 로그에 파라미터로 기록할 요청 바디 최대 크기
+const maxAuditBodySize = 64 * 1024
+
+// auditRedactKeys 값을 마스킹할 파라미터 이름 (부분 일치, 대소문자 무시)
+var auditRedactKeys = []string{"password", "secret", "token", "key", "code"}
+
+// deniedAuditLimiter 인증 거부(401, 403) 요청의 감사 로그 기록 속도 제한 (초당 1건, 최대 10건 연속)
+// 인증 실패 요청 반복으로 감사 로그가 디스크를 채우지 않도록 제한하며, 생략된 수는 다음 기록에 포함
+var deniedAuditLimiter = rate.NewLimiter(1, 10)
+
+// deniedAuditSuppressed 마지막 인증 거부 기록 이후 생략된 인증 거부 요청 수
+var deniedAuditSuppressed atomic.Uint64
+
+// auditMiddleware 변경 요청(GET, HEAD, OPTIONS 외) 감사 로그 기록 미들웨어
+// 접근 제어, 클라이언트 속도 제한으로 거부된 요청은 기록하지 않도록 해당 미들웨어 다음에 등록하며,
+// 등록된 라우트와 일치하지 않는 요청도 기록하지 않음
+//
+// Returns:
+//   - gin.HandlerFunc: gin 미들웨어
+func (s *Server) auditMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			c.Next()
+			return
+		}
+		if !config.Conf.Audit.Enabled || c.FullPath() == "" {
+			c.Next()
+			return
+		}
+
+		start := time.Now()
+		params := auditParams(c)
+
+		c.Next()
+
+		status := c.Writer.Status()
+		entry := audit.Entry{
+			Time:       start,
+			Source:     audit.SourceAPI,
+			Identity:   "anonymous",
+			ClientIP:   c.ClientIP(),
+			RequestID:  requestIDFrom(c),
+			Action:     c.Request.Method + " " + c.Request.URL.Path,
+			Params:     params,
+			Result:     audit.ResultSuccess,
+			Status:     status,
+			DurationMs: float64(time.Since(start).Microseconds()) / 1000,
+		}
+		if identity := identityFrom(c); identity != nil {
+			entry.Identity = identity.Name
+			entry.AuthMethod = identity.Method
+		}
+		if status >= http.StatusBadRequest {
+			entry.Result = audit.ResultFailure
+			entry.Error = http.StatusText(status)
+		}
+		if status == http.StatusUnauthorized || status == http.StatusForbidden {
+			if !deniedAuditLimiter.Allow() {
+				deniedAuditSuppressed.Add(1)
+				return
+			}
+			entry.Suppressed = deniedAuditSuppressed.Swap(0)
+		}
+
+		if err := audit.Record(entry); err != nil {
+			requestLogger(c).LogError("failed to write audit log: %v", err)
+		}
+	}
+}
+
+// auditParams 감사 로그에 기록할 요청 파라미터 추출 (쿼리, JSON 바디 최상위 필드)
+// 바디는 읽은 뒤 핸들러가 다시 읽을 수 있도록 복원하며, 비밀 값은 마스킹
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+//
+// Returns:
+//   - map[string]string: 파라미터 (없으면 nil)
+func auditParams(c *gin.Context) map[string]string {
+	params := make(map[string]string)
+	for name, values := range c.Request.URL.Query() {
+		params[name] = strings.Join(values, ",")
+	}
+
+	if c.Request.Body != nil && c.ContentType() == gin.MIMEJSON &&
+		c.Request.ContentLength >= 0 && c.Request.ContentLength <= maxAuditBodySize {
+		body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxAuditBodySize+1))
+		c.Request.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), c.Request.Body))
+		var fields map[string]interface{}
+		if err == nil && json.Unmarshal(body, &fields) == nil {
+			for name, value := range fields {
+				params[name] = fmt.Sprint(value)
+			}
+		}
+	}
+
+	for name := range params {
+		lower := strings.ToLower(name)
+		for _, redact := range auditRedactKeys {
+			if strings.Contains(lower, redact) {
+				params[name] = "[REDACTED]"
+				break
+			}
+		}
+	}
+
+	if len(params) == 0 {
+		return nil
+	}
+	return params
+}
@@ -0,0 +1,229 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package server
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/meloncoffee/unisys/config"
+	"github.com/meloncoffee/unisys/internal/auth"
+)
+
+// identityKey gin 컨텍스트에 인증 주체 정보를 저장하는 키
+const identityKey = "unisys.identity"
+
+// 인증 관련 에러 코드
+const (
+	ErrCodeUnauthorized = "unauthorized"
+	ErrCodeForbidden    = "forbidden"
+)
+
+// tokenStore API 토큰 저장소
+var tokenStore *auth.TokenStore
+
+// identityFrom gin 컨텍스트에서 인증 주체 정보 획득
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+//
+// Returns:
+//   - *auth.Identity: 인증 주체 정보 (미인증 시 nil)
+func identityFrom(c *gin.Context) *auth.Identity {
+	if v, ok := c.Get(identityKey); ok {
+		if identity, ok := v.(*auth.Identity); ok {
+			return identity
+		}
+	}
+	return nil
+}
+
+// bearerToken 요청 헤더에서 토큰 추출 (Authorization: Bearer, X-API-Key)
+//
+// Parameters:
+//   - r: HTTP 요청
+//
+// Returns:
+//   - string: 토큰 (없으면 빈 문자열)
+func bearerToken(r *http.Request) string {
+	if scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " "); ok &&
+		strings.EqualFold(scheme, "Bearer") {
+		return strings.TrimSpace(token)
+	}
+	return strings.TrimSpace(r.Header.Get("X-API-Key"))
+}
+
+// authMiddleware 요청 자격 증명 확인 미들웨어
+// 자격 증명(토큰, 세션 쿠키, 클라이언트 인증서, 유닉스 소켓 접속 사용자)이 있으면 검증하여 컨텍스트에 인증 주체를 저장하며,
+// 자격 증명이 없는 요청의 허용 여부는 라우트별 requireScope에서 결정
+//
+// Returns:
+//   - gin.HandlerFunc: gin 미들웨어
+func (s *Server) authMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 클라이언트 인증서는 인증 사용 여부와 무관하게 접근 로그 기록을 위해 확인
+		certIdentity, certErr := clientCertIdentity(c.Request)
+
+		if !config.Conf.Auth.Enabled {
+			if session, ok := cookieSession(c); ok {
+				c.Set(identityKey, session.Identity)
+				c.Set(sessionKey, &session)
+			} else if certIdentity != nil {
+				c.Set(identityKey, certIdentity)
+			} else if peer := peerIdentity(c.Request); peer != nil {
+				c.Set(identityKey, peer)
+			}
+			c.Next()
+			return
+		}
+
+		// 토큰 > 세션 쿠키 > 클라이언트 인증서 > 유닉스 소켓 접속 사용자 순으로 사용
+		if token := bearerToken(c.Request); token != "" {
+			identity, err := authenticateBearer(c, token)
+			if err != nil {
+				requestLogger(c).LogWarn("token authentication failed (IP: %s): %v", c.ClientIP(), err)
+				abortUnauthorized(c, "invalid or expired token")
+				return
+			}
+			c.Set(identityKey, identity)
+		} else if session, ok := cookieSession(c); ok {
+			if !csrfValid(c, session) {
+				requestLogger(c).LogWarn("csrf token mismatch (identity: %s, IP: %s, route: %s %s)",
+					session.Identity, c.ClientIP(), c.Request.Method, c.Request.URL.Path)
+				abortWithError(c, http.StatusForbidden, ErrCodeCSRF,
+					"missing or invalid "+csrfHeaderName+" header")
+				return
+			}
+			c.Set(identityKey, session.Identity)
+			c.Set(sessionKey, &session)
+		} else if certErr != nil {
+			requestLogger(c).LogWarn("client certificate rejected (IP: %s): %v", c.ClientIP(), certErr)
+			abortWithError(c, http.StatusForbidden, ErrCodeForbidden, "client certificate not accepted")
+			return
+		} else if certIdentity != nil {
+			c.Set(identityKey, certIdentity)
+		} else if peer := peerIdentity(c.Request); peer != nil {
+			// 유닉스 소켓 접속은 소켓 파일 권한으로 접근이 제한되므로 접속 사용자로 인증
+			c.Set(identityKey, peer)
+		}
+
+		c.Next()
+	}
+}
+
+// authenticateBearer 베어러 토큰 검증
+// OIDC 사용 시 JWT 형식 토큰은 발급자 서명으로, 그 외에는 토큰 저장소로 검증
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+//   - token: 베어러 토큰
+//
+// Returns:
+//   - *auth.Identity: 인증 주체 정보
+//   - error: 성공(nil), 실패(error)
+func authenticateBearer(c *gin.Context, token string) (*auth.Identity, error) {
+	if oidcProvider != nil && auth.IsJWT(token) {
+		return oidcProvider.VerifyBearer(c.Request.Context(), token)
+	}
+	return tokenStore.Authenticate(token)
+}
+
+// cookieSession 요청의 세션 쿠키로 세션 조회
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+//
+// Returns:
+//   - auth.Session: 세션 정보
+//   - bool: 유효한 세션 존재(true), 없거나 만료(false)
+func cookieSession(c *gin.Context) (auth.Session, bool) {
+	id, err := c.Cookie(sessionCookieName)
+	if err != nil || id == "" {
+		return auth.Session{}, false
+	}
+	return sessionStore.Get(id)
+}
+
+// csrfValid 세션 인증 요청의 CSRF 토큰 확인
+// 안전한 메서드(GET, HEAD, OPTIONS)와 로그인 요청은 확인하지 않음
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+//   - session: 세션 정보
+//
+// Returns:
+//   - bool: 유효(true), 불일치(false)
+func csrfValid(c *gin.Context, session auth.Session) bool {
+	switch c.Request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	if c.Request.URL.Path == apiBasePath+"/login" {
+		return true
+	}
+
+	token := c.GetHeader(csrfHeaderName)
+	return token != "" &&
+		subtle.ConstantTimeCompare([]byte(token), []byte(session.CSRFToken)) == 1
+}
+
+// requireScope 라우트별 권한 확인 미들웨어
+// 권한 범위를 보유했거나 역할의 경로/메서드 권한이 일치해야 허용
+// (역할의 경로 권한은 요청 경로가 아닌 /api/v1 라우트 경로와 비교)
+//
+// Parameters:
+//   - scope: 요구되는 권한 범위 (빈 문자열이면 인증 불필요)
+//
+// Returns:
+//   - gin.HandlerFunc: gin 미들웨어
+func requireScope(scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !config.Conf.Auth.Enabled || scope == "" {
+			c.Next()
+			return
+		}
+
+		identity := identityFrom(c)
+		if identity == nil {
+			abortUnauthorized(c, "authentication required")
+			return
+		}
+		// 별칭 경로는 대응하는 /api/v1 라우트 경로로 역할 권한 확인
+		if !identity.Allowed(scope, c.Request.Method, canonicalRoute(c.FullPath())) {
+			requestLogger(c).LogWarn("access denied (identity: %s, roles: %s, route: %s %s, required scope: %s)",
+				identity, strings.Join(identity.Roles, ","), c.Request.Method, c.Request.URL.Path, scope)
+			abortWithError(c, http.StatusForbidden, ErrCodeForbidden,
+				"access to "+c.Request.Method+" "+c.Request.URL.Path+" is not permitted (requires scope "+
+					scope+" or a role permitting this route)")
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// abortUnauthorized 401 응답 전송 (WWW-Authenticate 헤더 포함)
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+//   - detail: 상세 설명
+func abortUnauthorized(c *gin.Context, detail string) {
+	c.Header("WWW-Authenticate", `Bearer realm="`+config.ModuleName+`"`)
+	abortWithError(c, http.StatusUnauthorized, ErrCodeUnauthorized, detail)
+}
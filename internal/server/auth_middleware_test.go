@@ -0,0 +1,106 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/meloncoffee/unisys/config"
+	"github.com/meloncoffee/unisys/internal/auth"
+	"github.com/meloncoffee/unisys/internal/logger"
+)
+
+// TestMain 로그 파일이 임시 디렉터리에 생성되도록 작업 경로를 변경한 뒤 로거 초기화
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "unisys-server-test")
+	if err != nil {
+		panic(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		panic(err)
+	}
+	gin.SetMode(gin.TestMode)
+	logger.Log.InitializeLogger()
+
+	code := m.Run()
+
+	logger.Log.FinalizeLogger()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+func TestAuthMiddlewareCSRF(t *testing.T) {
+	savedAuth := config.Conf.Auth.Enabled
+	savedStore := sessionStore
+	t.Cleanup(func() {
+		config.Conf.Auth.Enabled = savedAuth
+		sessionStore = savedStore
+	})
+	config.Conf.Auth.Enabled = true
+	sessionStore = auth.NewSessionStore(time.Minute, time.Hour, nil)
+
+	session, err := sessionStore.Create(auth.NewIdentity("alice", auth.MethodPassword, []string{auth.RoleAdmin}, nil))
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	r := gin.New()
+	r.Use((&Server{}).authMiddleware())
+	handler := func(c *gin.Context) { c.String(http.StatusOK, identityFrom(c).Name) }
+	r.GET(apiBasePath+"/session", handler)
+	r.POST(apiBasePath+"/logout", handler)
+	r.POST(apiBasePath+"/login", handler)
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		cookie     string
+		csrf       string
+		wantStatus int
+	}{
+		{"safe method without token", http.MethodGet, apiBasePath + "/session", session.ID, "", http.StatusOK},
+		{"unsafe method without token", http.MethodPost, apiBasePath + "/logout", session.ID, "", http.StatusForbidden},
+		{"unsafe method with wrong token", http.MethodPost, apiBasePath + "/logout", session.ID, "wrong", http.StatusForbidden},
+		{"unsafe method with session token", http.MethodPost, apiBasePath + "/logout", session.ID, session.CSRFToken, http.StatusOK},
+		{"login without token", http.MethodPost, apiBasePath + "/login", session.ID, "", http.StatusOK},
+		{"tampered token", http.MethodPost, apiBasePath + "/logout", session.ID, "x" + session.CSRFToken[1:], http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.AddCookie(&http.Cookie{Name: sessionCookieName, Value: tt.cookie})
+			if tt.csrf != "" {
+				req.Header.Set(csrfHeaderName, tt.csrf)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body: %s)", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if w.Code == http.StatusOK && w.Body.String() != "alice" {
+				t.Errorf("identity = %q, want alice", w.Body.String())
+			}
+		})
+	}
+}
@@ -21,9 +21,33 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/meloncoffee/unisys/config"
+	"github.com/meloncoffee/unisys/internal/forecast"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
+// HealthResponse 헬스 체크 응답 구조체
+type HealthResponse struct {
+	Status string `json:"status"` // 상태 (ok)
+}
+
+// VersionResponse 버전 정보 응답 구조체
+type VersionResponse struct {
+	Source  string `json:"source"`  // 소스 저장소
+	Version string `json:"version"` // 모듈 버전
+}
+
+// RootResponse 루트 경로 응답 구조체
+type RootResponse struct {
+	Text string `json:"text"` // 안내 메시지
+}
+
+// ForecastResponse 리소스 고갈 시점 예측 응답 구조체
+type ForecastResponse struct {
+	Enabled        bool                `json:"enabled"`        // 예측 사용 여부
+	LookbackWindow int                 `json:"lookbackWindow"` // 선형 회귀 이력 기간(초)
+	Forecasts      []forecast.Forecast `json:"forecasts"`      // 예측 결과 목록
+}
+
 // metricsHandler prometheus 메트릭 제공 핸들러
 //
 // Parameters:
@@ -37,7 +61,7 @@ func metricsHandler(c *gin.Context) {
 // Parameters:
 //   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
 func healthHandler(c *gin.Context) {
-	c.AbortWithStatus(http.StatusOK)
+	c.JSON(http.StatusOK, HealthResponse{Status: "ok"})
 }
 
 // versionHandler 버전 정보 핸들러
@@ -45,9 +69,9 @@ func healthHandler(c *gin.Context) {
 // Parameters:
 //   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
 func versionHandler(c *gin.Context) {
-	c.JSON(http.StatusOK, gin.H{
-		"source":  "https://github.com/meloncoffee/unisys",
-		"version": config.Version,
+	c.JSON(http.StatusOK, VersionResponse{
+		Source:  "https://github.com/meloncoffee/unisys",
+		Version: config.Version,
 	})
 }
 
@@ -56,8 +80,8 @@ func versionHandler(c *gin.Context) {
 // Parameters:
 //   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
 func rootHandler(c *gin.Context) {
-	c.JSON(http.StatusOK, gin.H{
-		"text": "Welcome to unisys.",
+	c.JSON(http.StatusOK, RootResponse{
+		Text: "Welcome to unisys.",
 	})
 }
 
@@ -68,3 +92,15 @@ func rootHandler(c *gin.Context) {
 func sysStatsHandler(c *gin.Context) {
 	c.JSON(http.StatusOK, servStats.Data())
 }
+
+// forecastHandler 리소스 고갈 시점 예측 정보 핸들러
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+func forecastHandler(c *gin.Context) {
+	c.JSON(http.StatusOK, ForecastResponse{
+		Enabled:        config.Conf.Forecast.Enabled,
+		LookbackWindow: config.Conf.Forecast.LookbackWindow,
+		Forecasts:      forecast.GetForecasts(),
+	})
+}
@@ -0,0 +1,97 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/meloncoffee/unisys/config"
+	"github.com/quic-go/quic-go"
+	"github.com/quic-go/quic-go/http3"
+)
+
+// configureHTTP3 TLS 리스너의 HTTP/3(QUIC) 서버 구성
+// TCP 서버와 같은 인증서, TLS 정책 및 gin 핸들러를 사용
+//
+// Parameters:
+//   - tlsConf: TCP 서버 TLS 설정
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func (l *listener) configureHTTP3(tlsConf *tls.Config) error {
+	if tlsConf.MaxVersion != 0 && tlsConf.MaxVersion < tls.VersionTLS13 {
+		return fmt.Errorf("http3 requires TLS 1.3 (maxVersion is %s)", tls.VersionName(tlsConf.MaxVersion))
+	}
+
+	idleTimeout := time.Duration(config.Conf.Server.HTTP3.MaxIdleTimeout) * time.Second
+	l.h3 = &http3.Server{
+		Addr:           l.address,
+		Handler:        l.server.Handler,
+		TLSConfig:      tlsConf.Clone(),
+		MaxHeaderBytes: config.Conf.Server.MaxHeaderBytes,
+		IdleTimeout:    idleTimeout,
+		QUICConfig: &quic.Config{
+			MaxIdleTimeout: idleTimeout,
+			// 0-RTT 요청은 재전송 공격에 취약하므로 사용하지 않음
+			Allow0RTT: false,
+		},
+		// 요청 컨텍스트에 리스너 정보 저장
+		ConnContext: func(ctx context.Context, _ quic.Connection) context.Context {
+			return context.WithValue(ctx, listenerKey{}, l)
+		},
+	}
+
+	// HTTP/1.1, HTTP/2 응답에 Alt-Svc 헤더로 HTTP/3 광고
+	l.server.Handler = l.advertiseHTTP3(l.server.Handler)
+	return nil
+}
+
+// listenHTTP3 HTTP/3 UDP 소켓 생성 및 Alt-Svc 헤더 값 설정
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func (l *listener) listenHTTP3() error {
+	conn, err := net.ListenPacket("udp", l.address)
+	if err != nil {
+		return err
+	}
+	l.packetConn = conn
+	l.altSvc = fmt.Sprintf(`h3=":%d"; ma=%d`,
+		conn.LocalAddr().(*net.UDPAddr).Port, config.Conf.Server.HTTP3.AltSvcMaxAge)
+	return nil
+}
+
+// advertiseHTTP3 Alt-Svc 헤더 추가 핸들러
+//
+// Parameters:
+//   - next: 다음 핸들러
+//
+// Returns:
+//   - http.Handler: Alt-Svc 헤더를 추가하는 핸들러
+func (l *listener) advertiseHTTP3(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor < 3 && l.altSvc != "" {
+			w.Header().Set("Alt-Svc", l.altSvc)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,315 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package server
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/user"
+	"path"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/meloncoffee/unisys/config"
+	"github.com/meloncoffee/unisys/internal/auth"
+	"github.com/quic-go/quic-go/http3"
+)
+
+// listenerKey 요청 컨텍스트에 리스너 정보를 저장하는 키
+type listenerKey struct{}
+
+// peerCredKey 요청 컨텍스트에 유닉스 소켓 접속 프로세스 자격 증명을 저장하는 키
+type peerCredKey struct{}
+
+// listener 리스너 정보 구조체
+type listener struct {
+	conf         config.ListenerYaml // 리스너 설정
+	network      string              // 네트워크 종류 (tcp, unix)
+	address      string              // 리슨 주소 (unix는 소켓 파일 경로)
+	tls          bool                // TLS 사용 여부
+	clientCAPool *x509.CertPool      // 클라이언트 인증서 검증용 CA 풀
+	server       *http.Server        // HTTP 서버
+	ln           net.Listener        // 네트워크 리스너
+	h3           *http3.Server       // HTTP/3 서버 (미사용 시 nil)
+	packetConn   net.PacketConn      // HTTP/3 UDP 소켓
+	altSvc       string              // HTTP/3 광고용 Alt-Svc 헤더 값
+}
+
+// listenerConfigs 가동할 리스너 설정 목록
+// listeners 설정이 없으면 port 및 TLS 설정으로 단일 리스너 구성
+//
+// Returns:
+//   - []config.ListenerYaml: 리스너 설정 목록
+func listenerConfigs() []config.ListenerYaml {
+	if len(config.Conf.Server.Listeners) > 0 {
+		return config.Conf.Server.Listeners
+	}
+
+	address := ":" + strconv.Itoa(config.Conf.Server.Port)
+	if config.Conf.Server.AutoTLS.Enabled {
+		address = ":https"
+	}
+	return []config.ListenerYaml{{
+		Name:         "default",
+		Address:      address,
+		TLS:          "auto",
+		ClientAuth:   config.Conf.Server.ClientAuth,
+		ClientCAFile: config.Conf.Server.ClientCAFile,
+		Routes:       []string{"all"},
+	}}
+}
+
+// newListener 리스너 설정 해석
+//
+// Parameters:
+//   - conf: 리스너 설정
+//
+// Returns:
+//   - *listener: 리스너 정보
+//   - error: 성공(nil), 실패(error)
+func newListener(conf config.ListenerYaml) (*listener, error) {
+	l := &listener{conf: conf, network: "tcp", address: conf.Address}
+	if socketPath, ok := strings.CutPrefix(conf.Address, "unix:"); ok {
+		if socketPath == "" {
+			return nil, fmt.Errorf("unix socket path is empty")
+		}
+		l.network = "unix"
+		l.address = socketPath
+		if err := auth.ValidateRoles(conf.SocketRoles); err != nil {
+			return nil, fmt.Errorf("socketRoles: %v", err)
+		}
+	} else if _, _, err := net.SplitHostPort(conf.Address); err != nil {
+		return nil, fmt.Errorf("invalid address %q (expected [host]:port or unix:/path)", conf.Address)
+	}
+
+	// 잘못된 클라이언트 인증서 요구 방식으로 mTLS 없이 가동되지 않도록 TLS 사용 여부와 관계없이 검사
+	if err := validClientAuth(conf.ClientAuth); err != nil {
+		return nil, err
+	}
+
+	switch conf.TLS {
+	case "on":
+		l.tls = true
+	case "auto":
+		l.tls = l.network == "tcp" &&
+			(config.Conf.Server.AutoTLS.Enabled || config.Conf.Server.TLSEnabled)
+	}
+
+	return l, nil
+}
+
+// listen 리스너 주소로 리슨 시작
+// 유닉스 소켓은 이전 실행에서 남은 소켓 파일을 제거하고 파일 권한을 설정
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func (l *listener) listen() error {
+	if l.network == "tcp" {
+		ln, err := net.Listen("tcp", l.address)
+		if err != nil {
+			return err
+		}
+		// PROXY protocol 사용 시 헤더를 읽도록 감싸기
+		if config.Conf.Server.ProxyProtocol {
+			wrapped, err := wrapProxyProtocol(ln)
+			if err != nil {
+				ln.Close()
+				return fmt.Errorf("invalid proxy protocol options: %v", err)
+			}
+			ln = wrapped
+		}
+		// 같은 주소의 UDP 포트로 HTTP/3 리슨
+		if l.h3 != nil {
+			if err := l.listenHTTP3(); err != nil {
+				ln.Close()
+				return fmt.Errorf("http3: %v", err)
+			}
+		}
+		l.ln = ln
+		return nil
+	}
+
+	mode, err := strconv.ParseUint(l.conf.SocketMode, 8, 32)
+	if err != nil || mode > 0o777 {
+		return fmt.Errorf("invalid socketMode %q (octal, e.g. 0660)", l.conf.SocketMode)
+	}
+	if err := removeStaleSocket(l.address); err != nil {
+		return err
+	}
+
+	// 권한 설정 전 다른 사용자가 접속하지 않도록 umask로 생성 시점 권한 제한
+	oldMask := syscall.Umask(0o177)
+	ln, err := net.Listen("unix", l.address)
+	syscall.Umask(oldMask)
+	if err != nil {
+		return err
+	}
+	if err := os.Chmod(l.address, os.FileMode(mode)); err != nil {
+		ln.Close()
+		return fmt.Errorf("failed to set socket permissions: %v", err)
+	}
+	l.ln = ln
+	return nil
+}
+
+// close 서버 가동 전 리스너 소켓 닫기
+func (l *listener) close() {
+	if l.ln != nil {
+		l.ln.Close()
+	}
+	if l.packetConn != nil {
+		l.packetConn.Close()
+	}
+}
+
+// removeStaleSocket 사용 중이지 않은 유닉스 소켓 파일 제거
+//
+// Parameters:
+//   - socketPath: 소켓 파일 경로
+//
+// Returns:
+//   - error: 성공(nil), 다른 프로세스가 사용 중이거나 소켓이 아닌 파일(error)
+func removeStaleSocket(socketPath string) error {
+	fi, err := os.Lstat(socketPath)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if fi.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("%s exists and is not a socket", socketPath)
+	}
+
+	if conn, err := net.Dial("unix", socketPath); err == nil {
+		conn.Close()
+		return fmt.Errorf("%s is in use by another process", socketPath)
+	}
+	return os.Remove(socketPath)
+}
+
+// displayAddress 로그 표시용 리슨 주소
+//
+// Returns:
+//   - string: 리슨 주소
+func (l *listener) displayAddress() string {
+	if l.network == "unix" {
+		return "unix:" + l.address
+	}
+	return l.address
+}
+
+// serves 리스너가 라우트를 제공하는지 확인
+// routes 항목은 all, 라우트 그룹(OpenAPI 태그) 또는 경로 패턴
+//
+// Parameters:
+//   - tag: 라우트 그룹
+//   - fullPath: 라우트 전체 경로
+//
+// Returns:
+//   - bool: 제공(true), 미제공(false)
+func (l *listener) serves(tag, fullPath string) bool {
+	for _, route := range l.conf.Routes {
+		if route == "all" || route == tag {
+			return true
+		}
+		if strings.HasPrefix(route, "/") && auth.MatchPath(route, path.Clean(fullPath)) {
+			return true
+		}
+	}
+	return false
+}
+
+// listenerFrom 요청 컨텍스트에서 리스너 정보 획득
+//
+// Parameters:
+//   - ctx: 요청 컨텍스트
+//
+// Returns:
+//   - *listener: 리스너 정보 (없으면 nil)
+func listenerFrom(ctx context.Context) *listener {
+	l, _ := ctx.Value(listenerKey{}).(*listener)
+	return l
+}
+
+// isUnixRequest 유닉스 소켓 리스너로 들어온 요청인지 확인
+//
+// Parameters:
+//   - r: HTTP 요청
+//
+// Returns:
+//   - bool: 유닉스 소켓 요청(true), TCP 요청(false)
+func isUnixRequest(r *http.Request) bool {
+	l := listenerFrom(r.Context())
+	return l != nil && l.network == "unix"
+}
+
+// peerCredContext 유닉스 소켓 연결의 접속 프로세스 자격 증명(SO_PEERCRED)을 연결 컨텍스트에 저장
+//
+// Parameters:
+//   - ctx: 연결 컨텍스트
+//   - c: 네트워크 연결
+//
+// Returns:
+//   - context.Context: 자격 증명이 저장된 컨텍스트
+func peerCredContext(ctx context.Context, c net.Conn) context.Context {
+	uc, ok := c.(*net.UnixConn)
+	if !ok {
+		return ctx
+	}
+	raw, err := uc.SyscallConn()
+	if err != nil {
+		return ctx
+	}
+
+	var cred *syscall.Ucred
+	raw.Control(func(fd uintptr) {
+		cred, err = syscall.GetsockoptUcred(int(fd), syscall.SOL_SOCKET, syscall.SO_PEERCRED)
+	})
+	if err != nil || cred == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, peerCredKey{}, cred)
+}
+
+// peerIdentity 유닉스 소켓 접속 프로세스의 인증 주체 생성
+// 소켓 파일 권한으로 접속 가능한 사용자를 제한하므로 접속 자체를 인증으로 간주
+//
+// Parameters:
+//   - r: HTTP 요청
+//
+// Returns:
+//   - *auth.Identity: 인증 주체 정보 (유닉스 소켓 요청이 아니면 nil)
+func peerIdentity(r *http.Request) *auth.Identity {
+	l := listenerFrom(r.Context())
+	if l == nil || l.network != "unix" {
+		return nil
+	}
+
+	name := "unknown"
+	if cred, ok := r.Context().Value(peerCredKey{}).(*syscall.Ucred); ok {
+		name = strconv.FormatUint(uint64(cred.Uid), 10)
+		if u, err := user.LookupId(name); err == nil {
+			name = u.Username
+		}
+	}
+	return auth.NewIdentity(name, auth.MethodUnix, l.conf.SocketRoles, nil)
+}
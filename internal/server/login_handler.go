@@ -0,0 +1,214 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package server
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/meloncoffee/unisys/internal/auth"
+)
+
+// 세션 쿠키 및 헤더 이름
+const (
+	sessionCookieName = "unisys_session"
+	csrfCookieName    = "unisys_csrf"
+	csrfHeaderName    = "X-CSRF-Token"
+)
+
+// sessionKey gin 컨텍스트에 세션 정보를 저장하는 키
+const sessionKey = "unisys.session"
+
+// 로그인 관련 에러 코드
+const (
+	ErrCodeAccountLocked = "account_locked"
+	ErrCodeCSRF          = "csrf_failed"
+)
+
+var (
+	// userStore 로그인 사용자 저장소
+	userStore *auth.UserStore
+	// sessionStore 로그인 세션 저장소
+	sessionStore *auth.SessionStore
+	// loginLimiter 로그인 실패 잠금 관리자
+	loginLimiter *auth.LoginLimiter
+)
+
+// LoginRequest 로그인 요청 구조체
+type LoginRequest struct {
+	Username string `json:"username" binding:"required"` // 사용자명
+	Password string `json:"password" binding:"required"` // 비밀번호
+}
+
+// LoginResponse 로그인 응답 구조체
+type LoginResponse struct {
+	Username  string    `json:"username"`  // 사용자명
+	Roles     []string  `json:"roles"`     // 역할 목록
+	ExpiresAt time.Time `json:"expiresAt"` // 세션 절대 만료 시간
+	CSRFToken string    `json:"csrfToken"` // X-CSRF-Token 헤더에 사용할 토큰
+}
+
+// SessionResponse 현재 인증 주체 응답 구조체
+type SessionResponse struct {
+	Name      string     `json:"name"`                // 인증 주체 이름
+	Method    string     `json:"method"`              // 인증 방식 (token, cert, password)
+	Roles     []string   `json:"roles"`               // 역할 목록
+	Scopes    []string   `json:"scopes"`              // 직접 부여된 권한 범위 목록
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"` // 세션 절대 만료 시간 (세션 인증 시)
+}
+
+// sessionFrom gin 컨텍스트에서 세션 정보 획득
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+//
+// Returns:
+//   - *auth.Session: 세션 정보 (세션 인증이 아니면 nil)
+func sessionFrom(c *gin.Context) *auth.Session {
+	if v, ok := c.Get(sessionKey); ok {
+		if session, ok := v.(*auth.Session); ok {
+			return session
+		}
+	}
+	return nil
+}
+
+// setSessionCookies 세션 쿠키와 CSRF 쿠키 설정
+// CSRF 쿠키는 스크립트에서 읽어 헤더로 전송할 수 있도록 HttpOnly를 설정하지 않음
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+//   - id: 세션 ID (빈 문자열이면 쿠키 삭제)
+//   - csrf: CSRF 토큰
+//   - maxAge: 쿠키 유지 시간(초, 음수면 삭제)
+func setSessionCookies(c *gin.Context, id, csrf string, maxAge int) {
+	secure := c.Request.TLS != nil
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     sessionCookieName,
+		Value:    id,
+		Path:     "/",
+		MaxAge:   maxAge,
+		Secure:   secure,
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+	})
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     csrfCookieName,
+		Value:    csrf,
+		Path:     "/",
+		MaxAge:   maxAge,
+		Secure:   secure,
+		SameSite: http.SameSiteStrictMode,
+	})
+}
+
+// loginHandler 사용자명/비밀번호 로그인 핸들러
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+func loginHandler(c *gin.Context) {
+	var req LoginRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	if remaining := loginLimiter.Locked(req.Username); remaining > 0 {
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(remaining.Seconds()))))
+		abortWithError(c, http.StatusTooManyRequests, ErrCodeAccountLocked,
+			"too many failed login attempts, try again later")
+		return
+	}
+
+	identity, err := userStore.Authenticate(req.Username, req.Password)
+	if err != nil {
+		if !errors.Is(err, auth.ErrInvalidCredentials) {
+			requestLogger(c).LogError("failed to authenticate user: %v", err)
+			abortWithError(c, http.StatusInternalServerError, ErrCodeInternal, "internal server error")
+			return
+		}
+		if loginLimiter.Failure(req.Username) {
+			requestLogger(c).LogWarn("account locked after repeated login failures (user: %s, IP: %s)",
+				req.Username, c.ClientIP())
+		} else {
+			requestLogger(c).LogWarn("login failed (user: %s, IP: %s)", req.Username, c.ClientIP())
+		}
+		abortUnauthorized(c, "invalid username or password")
+		return
+	}
+	loginLimiter.Success(req.Username)
+	c.Set(identityKey, identity)
+
+	// 세션 고정 공격 방지를 위해 기존 세션은 폐기
+	if old, err := c.Cookie(sessionCookieName); err == nil && old != "" {
+		sessionStore.Delete(old)
+	}
+
+	session, err := sessionStore.Create(identity)
+	if err != nil {
+		requestLogger(c).LogError("failed to create session: %v", err)
+		abortWithError(c, http.StatusInternalServerError, ErrCodeInternal, "internal server error")
+		return
+	}
+	setSessionCookies(c, session.ID, session.CSRFToken, int(time.Until(session.ExpiresAt).Seconds()))
+	requestLogger(c).LogInfo("login succeeded (user: %s, IP: %s)", identity.Name, c.ClientIP())
+
+	c.JSON(http.StatusOK, LoginResponse{
+		Username:  identity.Name,
+		Roles:     identity.Roles,
+		ExpiresAt: session.ExpiresAt,
+		CSRFToken: session.CSRFToken,
+	})
+}
+
+// logoutHandler 로그아웃 핸들러
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+func logoutHandler(c *gin.Context) {
+	if session := sessionFrom(c); session != nil {
+		sessionStore.Delete(session.ID)
+	}
+	setSessionCookies(c, "", "", -1)
+	c.Status(http.StatusNoContent)
+}
+
+// sessionHandler 현재 인증 주체 조회 핸들러
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+func sessionHandler(c *gin.Context) {
+	identity := identityFrom(c)
+	if identity == nil {
+		abortUnauthorized(c, "not authenticated")
+		return
+	}
+
+	resp := SessionResponse{
+		Name:   identity.Name,
+		Method: identity.Method,
+		Roles:  identity.Roles,
+		Scopes: identity.Scopes,
+	}
+	if session := sessionFrom(c); session != nil {
+		resp.ExpiresAt = &session.ExpiresAt
+	}
+	c.JSON(http.StatusOK, resp)
+}
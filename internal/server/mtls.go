@@ -0,0 +1,162 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/meloncoffee/unisys/config"
+	"github.com/meloncoffee/unisys/internal/auth"
+)
+
+// validClientAuth 클라이언트 인증서 요구 방식 유효성 검사
+//
+// Parameters:
+//   - mode: 클라이언트 인증서 요구 방식
+//
+// Returns:
+//   - error: 유효(nil), 알 수 없는 방식(error)
+func validClientAuth(mode string) error {
+	switch mode {
+	case "none", "request", "require", "verify":
+		return nil
+	}
+	return fmt.Errorf("unknown clientAuth mode %q (none, request, require, verify)", mode)
+}
+
+// configureClientAuth 클라이언트 인증서(mTLS) 설정
+//
+// Parameters:
+//   - tlsConf: 서버 TLS 설정
+//   - mode: 클라이언트 인증서 요구 방식 (none/request/require/verify)
+//   - caFile: 클라이언트 인증서 검증용 CA 파일 경로
+//
+// Returns:
+//   - *x509.CertPool: 클라이언트 CA 풀 (CA 파일 미설정 시 nil)
+//   - error: 성공(nil), 실패(error)
+func configureClientAuth(tlsConf *tls.Config, mode, caFile string) (*x509.CertPool, error) {
+	if err := validClientAuth(mode); err != nil {
+		return nil, err
+	}
+	if mode == "none" {
+		return nil, nil
+	}
+
+	// 클라이언트 CA 로드
+	var pool *x509.CertPool
+	if caFile == "" {
+		if mode == "verify" {
+			return nil, fmt.Errorf("clientCAFile is required for clientAuth mode verify")
+		}
+	} else {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client CA file: %v", err)
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in client CA file (%s)", caFile)
+		}
+		tlsConf.ClientCAs = pool
+	}
+
+	switch mode {
+	case "request":
+		tlsConf.ClientAuth = tls.RequestClientCert
+	case "require":
+		tlsConf.ClientAuth = tls.RequireAnyClientCert
+	case "verify":
+		tlsConf.ClientAuth = tls.RequireAndVerifyClientCert
+		// 핸드셰이크 단계에서 허용 목록 검사
+		tlsConf.VerifyConnection = func(cs tls.ConnectionState) error {
+			if len(cs.PeerCertificates) == 0 || !clientAllowed(cs.PeerCertificates[0]) {
+				return fmt.Errorf("client certificate is not in allowedClients")
+			}
+			return nil
+		}
+	}
+
+	return pool, nil
+}
+
+// clientAllowed 클라이언트 인증서가 허용 목록에 포함되는지 확인
+//
+// Parameters:
+//   - cert: 클라이언트 인증서
+//
+// Returns:
+//   - bool: 허용(true), 거부(false)
+func clientAllowed(cert *x509.Certificate) bool {
+	if len(config.Conf.Server.AllowedClients) == 0 {
+		return true
+	}
+	for _, pattern := range config.Conf.Server.AllowedClients {
+		if auth.MatchCert(cert, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientCertIdentity 요청의 클라이언트 인증서로부터 인증 주체 획득
+// 핸드셰이크에서 검증되지 않은 인증서(request/require 모드)는 클라이언트 CA로 직접 검증
+//
+// Parameters:
+//   - r: HTTP 요청
+//
+// Returns:
+//   - *auth.Identity: 인증 주체 정보 (인증서가 없으면 nil)
+//   - error: 성공(nil), 검증 실패 또는 허용 목록에 없음(error)
+func clientCertIdentity(r *http.Request) (*auth.Identity, error) {
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return nil, nil
+	}
+	cert := r.TLS.PeerCertificates[0]
+
+	if len(r.TLS.VerifiedChains) == 0 {
+		var clientCAPool *x509.CertPool
+		if l := listenerFrom(r.Context()); l != nil {
+			clientCAPool = l.clientCAPool
+		}
+		if clientCAPool == nil {
+			return nil, fmt.Errorf("cannot verify client certificate without clientCAFile")
+		}
+
+		intermediates := x509.NewCertPool()
+		for _, c := range r.TLS.PeerCertificates[1:] {
+			intermediates.AddCert(c)
+		}
+		_, err := cert.Verify(x509.VerifyOptions{
+			Roots:         clientCAPool,
+			Intermediates: intermediates,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("client certificate verification failed: %v", err)
+		}
+	}
+
+	if !clientAllowed(cert) {
+		return nil, fmt.Errorf("client certificate is not in allowedClients (%s)", cert.Subject)
+	}
+
+	return auth.CertIdentity(cert, config.Conf.Server.ClientRoles), nil
+}
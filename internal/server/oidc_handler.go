@@ -0,0 +1,146 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package server
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"html"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/meloncoffee/unisys/config"
+	"github.com/meloncoffee/unisys/internal/auth"
+)
+
+// oidcStateCookieName 인가 요청 state를 브라우저에 묶어두는 쿠키 이름
+const oidcStateCookieName = "unisys_oidc_state"
+
+// OIDC 관련 에러 코드
+const (
+	ErrCodeIdPUnavailable = "idp_unavailable"
+	ErrCodeLoginFailed    = "login_failed"
+)
+
+// oidcProvider OIDC 발급자 연동 (미사용 시 nil)
+var oidcProvider *auth.OIDCProvider
+
+// OIDCCallbackQuery OIDC 콜백 쿼리 파라미터 구조체
+type OIDCCallbackQuery struct {
+	Code             string `form:"code" description:"Authorization code"`
+	State            string `form:"state" description:"State issued by /oidc/login"`
+	Error            string `form:"error" description:"Error code returned by the identity provider"`
+	ErrorDescription string `form:"error_description" description:"Error description returned by the identity provider"`
+}
+
+// oidcLoginHandler OIDC 로그인 시작 핸들러 (발급자 인가 엔드포인트로 리다이렉트)
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+func oidcLoginHandler(c *gin.Context) {
+	if oidcProvider == nil {
+		abortWithError(c, http.StatusNotFound, ErrCodeNotFound, "oidc login is not enabled")
+		return
+	}
+
+	url, state, err := oidcProvider.AuthCodeURL(c.Request.Context())
+	if err != nil {
+		requestLogger(c).LogError("failed to start oidc login: %v", err)
+		abortWithError(c, http.StatusBadGateway, ErrCodeIdPUnavailable, "identity provider is unavailable")
+		return
+	}
+
+	// 발급자에서 돌아오는 요청은 교차 사이트 탐색이므로 Lax 사용
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     oidcStateCookieName,
+		Value:    state,
+		Path:     apiBasePath + "/oidc",
+		MaxAge:   int((10 * time.Minute).Seconds()),
+		Secure:   c.Request.TLS != nil,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+	c.Redirect(http.StatusFound, url)
+}
+
+// oidcCallbackHandler OIDC 인가 코드 콜백 핸들러
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+func oidcCallbackHandler(c *gin.Context) {
+	if oidcProvider == nil {
+		abortWithError(c, http.StatusNotFound, ErrCodeNotFound, "oidc login is not enabled")
+		return
+	}
+
+	var query OIDCCallbackQuery
+	if !bindQuery(c, &query) {
+		return
+	}
+
+	// state 쿠키는 결과와 관계없이 1회만 사용
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     oidcStateCookieName,
+		Path:     apiBasePath + "/oidc",
+		MaxAge:   -1,
+		Secure:   c.Request.TLS != nil,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	if query.Error != "" {
+		requestLogger(c).LogWarn("oidc login rejected by identity provider (IP: %s): %s %s",
+			c.ClientIP(), query.Error, query.ErrorDescription)
+		abortWithError(c, http.StatusUnauthorized, ErrCodeLoginFailed,
+			"identity provider returned "+query.Error)
+		return
+	}
+	stateCookie, _ := c.Cookie(oidcStateCookieName)
+	if query.Code == "" || query.State == "" || stateCookie == "" ||
+		subtle.ConstantTimeCompare([]byte(stateCookie), []byte(query.State)) != 1 {
+		abortWithError(c, http.StatusBadRequest, ErrCodeBadRequest, "missing or mismatched login state")
+		return
+	}
+
+	identity, err := oidcProvider.Exchange(c.Request.Context(), query.State, query.Code)
+	if err != nil {
+		requestLogger(c).LogWarn("oidc login failed (IP: %s): %v", c.ClientIP(), err)
+		abortWithError(c, http.StatusUnauthorized, ErrCodeLoginFailed, "oidc login failed")
+		return
+	}
+
+	c.Set(identityKey, identity)
+
+	session, err := sessionStore.Create(identity)
+	if err != nil {
+		requestLogger(c).LogError("failed to create session: %v", err)
+		abortWithError(c, http.StatusInternalServerError, ErrCodeInternal, "internal server error")
+		return
+	}
+	setSessionCookies(c, session.ID, session.CSRFToken, int(time.Until(session.ExpiresAt).Seconds()))
+	requestLogger(c).LogInfo("oidc login succeeded (user: %s, roles: %v, IP: %s)",
+		identity.Name, identity.Roles, c.ClientIP())
+
+	// 교차 사이트 리다이렉트 체인에서는 SameSite=Strict 쿠키가 전송되지 않으므로
+	// 302 대신 같은 사이트 탐색이 되도록 HTML로 이동
+	target := html.EscapeString(config.Conf.Auth.OIDC.PostLoginURL)
+	c.Header("Cache-Control", "no-store")
+	c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(fmt.Sprintf(
+		`<!DOCTYPE html><html><head><meta http-equiv="refresh" content="0;url=%s"></head>`+
+			`<body><a href="%s">Continue</a></body></html>`, target, target)))
+}
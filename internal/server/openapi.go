@@ -0,0 +1,393 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package server
+
+import (
+	"net/http"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/meloncoffee/unisys/config"
+)
+
+var (
+	// 런타임 중 한번만 생성되는 OpenAPI 문서
+	openAPIDoc  map[string]interface{}
+	openAPIOnce sync.Once
+)
+
+// openAPIHandler OpenAPI 3 문서 제공 핸들러
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+func openAPIHandler(c *gin.Context) {
+	openAPIOnce.Do(func() {
+		openAPIDoc = newOpenAPIDocument(apiBasePath, apiV1Routes())
+	})
+	c.JSON(http.StatusOK, openAPIDoc)
+}
+
+// newOpenAPIDocument 라우트 정의로부터 OpenAPI 3 문서 생성
+// 라우트 등록과 같은 정의를 사용하므로 실제 API와 문서가 어긋나지 않음
+//
+// Parameters:
+//   - basePath: 라우트 그룹 기준 경로
+//   - routes: 라우트 정의 목록
+//
+// Returns:
+//   - map[string]interface{}: OpenAPI 문서
+func newOpenAPIDocument(basePath string, routes []apiRoute) map[string]interface{} {
+	gen := newSchemaGenerator()
+	paths := make(map[string]interface{})
+
+	for _, route := range routes {
+		operation := map[string]interface{}{
+			"operationId": route.operationID,
+			"summary":     route.summary,
+			"tags":        []string{route.tag},
+		}
+		if route.description != "" {
+			operation["description"] = route.description
+		}
+
+		// 쿼리 파라미터 정의
+		if route.query != nil {
+			operation["parameters"] = gen.queryParameters(reflect.TypeOf(route.query))
+		}
+
+		// 요청 바디 정의
+		if route.body != nil {
+			operation["requestBody"] = map[string]interface{}{
+				"required": true,
+				"content": map[string]interface{}{
+					"application/json": map[string]interface{}{
+						"schema": gen.schemaOf(reflect.TypeOf(route.body)),
+					},
+				},
+			}
+		}
+
+		// 응답 정의
+		mediaType := route.contentType
+		if mediaType == "" {
+			mediaType = "application/json"
+		}
+		schema := map[string]interface{}{"type": "string"}
+		if route.response != nil {
+			schema = gen.schemaOf(reflect.TypeOf(route.response))
+		}
+		content := map[string]interface{}{
+			mediaType: map[string]interface{}{"schema": schema},
+		}
+		problem := map[string]interface{}{
+			"content": map[string]interface{}{
+				problemContentType: map[string]interface{}{
+					"schema": gen.schemaOf(reflect.TypeOf(Problem{})),
+				},
+			},
+		}
+		responses := map[string]interface{}{
+			"default": withDescription(problem, "Error (RFC 7807 problem details)"),
+		}
+		status := route.status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		success := map[string]interface{}{"description": http.StatusText(status)}
+		if status == http.StatusOK || route.response != nil {
+			success["content"] = content
+		}
+		responses[strconv.Itoa(status)] = success
+		if route.query != nil || route.body != nil {
+			responses["400"] = withDescription(problem, "Invalid request parameters")
+		}
+		if route.scope != "" {
+			responses["401"] = withDescription(problem, "Missing or invalid credentials")
+			responses["403"] = withDescription(problem, "Credentials lack the required scope")
+			operation["security"] = []interface{}{
+				map[string]interface{}{"bearerAuth": []string{}},
+				map[string]interface{}{"sessionCookie": []string{}},
+			}
+			operation["x-required-scope"] = route.scope
+		}
+		operation["responses"] = responses
+
+		// OpenAPI 경로 형식으로 변환 (:param -> {param})
+		fullPath := toOpenAPIPath(path.Join(basePath, route.path))
+		item, ok := paths[fullPath].(map[string]interface{})
+		if !ok {
+			item = make(map[string]interface{})
+			paths[fullPath] = item
+		}
+		item[strings.ToLower(route.method)] = operation
+	}
+
+	return map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]interface{}{
+			"title":   config.ModuleName + " API",
+			"version": config.Version,
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": gen.schemas,
+			"securitySchemes": map[string]interface{}{
+				"bearerAuth": map[string]interface{}{
+					"type":        "http",
+					"scheme":      "bearer",
+					"description": "API token or OIDC issuer JWT; only enforced when auth.enabled is true",
+				},
+				"sessionCookie": map[string]interface{}{
+					"type":        "apiKey",
+					"in":          "cookie",
+					"name":        sessionCookieName,
+					"description": "Session from POST " + basePath + "/login; unsafe methods also need X-CSRF-Token",
+				},
+			},
+		},
+	}
+}
+
+// withDescription 응답 정의에 설명을 추가한 복사본 반환
+//
+// Parameters:
+//   - response: 응답 정의
+//   - description: 설명
+//
+// Returns:
+//   - map[string]interface{}: 설명이 추가된 응답 정의
+func withDescription(response map[string]interface{}, description string) map[string]interface{} {
+	copied := make(map[string]interface{}, len(response)+1)
+	for k, v := range response {
+		copied[k] = v
+	}
+	copied["description"] = description
+	return copied
+}
+
+// toOpenAPIPath gin 경로 파라미터(:name, *name)를 OpenAPI 형식({name})으로 변환
+//
+// Parameters:
+//   - ginPath: gin 라우트 경로
+//
+// Returns:
+//   - string: OpenAPI 경로
+func toOpenAPIPath(ginPath string) string {
+	segments := strings.Split(ginPath, "/")
+	for i, seg := range segments {
+		if strings.HasPrefix(seg, ":") || strings.HasPrefix(seg, "*") {
+			segments[i] = "{" + seg[1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// schemaGenerator Go 타입으로부터 JSON 스키마를 생성하는 구조체
+type schemaGenerator struct {
+	schemas map[string]interface{}
+	names   map[reflect.Type]string
+}
+
+// newSchemaGenerator 스키마 생성기 생성
+//
+// Returns:
+//   - *schemaGenerator
+func newSchemaGenerator() *schemaGenerator {
+	return &schemaGenerator{
+		schemas: make(map[string]interface{}),
+		names:   make(map[reflect.Type]string),
+	}
+}
+
+// schemaOf 타입에 대한 스키마 생성 (이름이 있는 구조체는 components에 등록 후 참조)
+//
+// Parameters:
+//   - t: 타입 정보
+//
+// Returns:
+//   - map[string]interface{}: 스키마
+func (g *schemaGenerator) schemaOf(t reflect.Type) map[string]interface{} {
+	if t.Kind() == reflect.Pointer {
+		schema := g.schemaOf(t.Elem())
+		if _, isRef := schema["$ref"]; isRef {
+			return map[string]interface{}{
+				"allOf":    []interface{}{schema},
+				"nullable": true,
+			}
+		}
+		schema["nullable"] = true
+		return schema
+	}
+
+	if t == reflect.TypeOf(time.Time{}) {
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		return map[string]interface{}{"type": "integer", "format": "int32"}
+	case reflect.Int64:
+		return map[string]interface{}{"type": "integer", "format": "int64"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer", "format": "int64", "minimum": 0}
+	case reflect.Float32:
+		return map[string]interface{}{"type": "number", "format": "float"}
+	case reflect.Float64:
+		return map[string]interface{}{"type": "number", "format": "double"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]interface{}{"type": "string", "format": "byte"}
+		}
+		return map[string]interface{}{"type": "array", "items": g.schemaOf(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": g.schemaOf(t.Elem()),
+		}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.structSchema(t)
+		}
+		name := g.register(t)
+		return map[string]interface{}{"$ref": "#/components/schemas/" + name}
+	default:
+		// interface{} 등 임의의 값
+		return map[string]interface{}{}
+	}
+}
+
+// register 이름이 있는 구조체를 components에 등록
+//
+// Parameters:
+//   - t: 구조체 타입 정보
+//
+// Returns:
+//   - string: 등록된 스키마 이름
+func (g *schemaGenerator) register(t reflect.Type) string {
+	if name, ok := g.names[t]; ok {
+		return name
+	}
+
+	// 다른 패키지의 동일한 타입명과 충돌하면 패키지명을 접두어로 사용
+	name := t.Name()
+	if _, exists := g.schemas[name]; exists {
+		name = path.Base(t.PkgPath()) + "." + name
+	}
+	g.names[t] = name
+	// 재귀 타입을 위해 먼저 이름을 등록한 후 스키마 생성
+	g.schemas[name] = map[string]interface{}{}
+	g.schemas[name] = g.structSchema(t)
+
+	return name
+}
+
+// structSchema 구조체 필드의 json 태그로부터 object 스키마 생성
+//
+// Parameters:
+//   - t: 구조체 타입 정보
+//
+// Returns:
+//   - map[string]interface{}: 스키마
+func (g *schemaGenerator) structSchema(t reflect.Type) map[string]interface{} {
+	properties := make(map[string]interface{})
+	var required []string
+
+	g.collectFields(t, properties, &required)
+
+	schema := map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+// collectFields 구조체 필드를 스키마 속성으로 수집 (임베디드 구조체는 펼침)
+//
+// Parameters:
+//   - t: 구조체 타입 정보
+//   - properties: 속성 저장 맵
+//   - required: 필수 속성 목록
+func (g *schemaGenerator) collectFields(t reflect.Type, properties map[string]interface{}, required *[]string) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		// 이름 없는 임베디드 구조체는 필드를 상위 객체로 펼침
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			g.collectFields(field.Type, properties, required)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		properties[name] = g.schemaOf(field.Type)
+		if !strings.Contains(opts, "omitempty") {
+			*required = append(*required, name)
+		}
+	}
+}
+
+// queryParameters 구조체 필드의 form 태그로부터 쿼리 파라미터 정의 생성
+//
+// Parameters:
+//   - t: 쿼리 구조체 타입 정보
+//
+// Returns:
+//   - []interface{}: 파라미터 정의 목록
+func (g *schemaGenerator) queryParameters(t reflect.Type) []interface{} {
+	var params []interface{}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("form"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		param := map[string]interface{}{
+			"name":     name,
+			"in":       "query",
+			"required": strings.Contains(field.Tag.Get("binding"), "required"),
+			"schema":   g.schemaOf(field.Type),
+		}
+		if desc := field.Tag.Get("description"); desc != "" {
+			param["description"] = desc
+		}
+		params = append(params, param)
+	}
+
+	return params
+}
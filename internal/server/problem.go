@@ -0,0 +1,127 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package server
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// problemContentType RFC 7807 응답 Content-Type
+const problemContentType = "application/problem+json"
+
+// problemTypePrefix 에러 코드로부터 problem type URI를 만들기 위한 접두어
+const problemTypePrefix = "urn:unisys:problem:"
+
+// 기계가 판독 가능한 에러 코드
+const (
+	ErrCodeBadRequest       = "bad_request"
+	ErrCodeValidation       = "validation_failed"
+	ErrCodeNotFound         = "not_found"
+	ErrCodeMethodNotAllowed = "method_not_allowed"
+	ErrCodeInternal         = "internal_error"
+)
+
+// Problem RFC 7807 problem details 응답 구조체
+type Problem struct {
+	Type     string       `json:"type"`               // 에러 종류 URI (urn:unisys:problem:<code>)
+	Title    string       `json:"title"`              // HTTP 상태 코드 설명
+	Status   int          `json:"status"`             // HTTP 상태 코드
+	Code     string       `json:"code"`               // 기계가 판독 가능한 에러 코드
+	Detail   string       `json:"detail,omitempty"`   // 상세 설명
+	Instance string       `json:"instance,omitempty"` // 요청 경로
+	Errors   []FieldError `json:"errors,omitempty"`   // 요청 값 검증 실패 목록
+}
+
+// FieldError 요청 값 검증 실패 정보 구조체
+type FieldError struct {
+	Field   string `json:"field"`   // 파라미터명
+	Rule    string `json:"rule"`    // 실패한 검증 규칙
+	Message string `json:"message"` // 설명
+}
+
+// newProblem problem details 생성
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+//   - status: HTTP 상태 코드
+//   - code: 에러 코드
+//   - detail: 상세 설명
+//
+// Returns:
+//   - Problem: problem details
+func newProblem(c *gin.Context, status int, code, detail string) Problem {
+	return Problem{
+		Type:     problemTypePrefix + code,
+		Title:    http.StatusText(status),
+		Status:   status,
+		Code:     code,
+		Detail:   detail,
+		Instance: c.Request.URL.Path,
+	}
+}
+
+// abortWithProblem problem+json 응답 전송 후 요청 처리 중단
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+//   - problem: problem details
+func abortWithProblem(c *gin.Context, problem Problem) {
+	// gin은 Content-Type이 이미 설정된 경우 덮어쓰지 않음
+	c.Header("Content-Type", problemContentType)
+	c.AbortWithStatusJSON(problem.Status, problem)
+}
+
+// abortWithError 에러 코드와 상세 설명으로 problem+json 응답 전송
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+//   - status: HTTP 상태 코드
+//   - code: 에러 코드
+//   - detail: 상세 설명
+func abortWithError(c *gin.Context, status int, code, detail string) {
+	abortWithProblem(c, newProblem(c, status, code, detail))
+}
+
+// noRouteHandler 등록되지 않은 경로 핸들러
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+func noRouteHandler(c *gin.Context) {
+	abortWithError(c, http.StatusNotFound, ErrCodeNotFound, "no route for "+c.Request.URL.Path)
+}
+
+// noMethodHandler 허용되지 않은 메서드 핸들러
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+func noMethodHandler(c *gin.Context) {
+	abortWithError(c, http.StatusMethodNotAllowed, ErrCodeMethodNotAllowed,
+		c.Request.Method+" is not allowed for "+c.Request.URL.Path)
+}
+
+// recoveryHandler 핸들러 패닉 발생 시 호출되는 복구 핸들러
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+//   - err: 패닉 값
+func recoveryHandler(c *gin.Context, err interface{}) {
+	requestLogger(c).LogError("panic recovered while handling %s %s: %v",
+		c.Request.Method, c.Request.URL.Path, err)
+	abortWithError(c, http.StatusInternalServerError, ErrCodeInternal, "internal server error")
+}
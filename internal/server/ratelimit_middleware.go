@@ -0,0 +1,101 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package server
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/meloncoffee/unisys/config"
+	"github.com/meloncoffee/unisys/internal/ratelimit"
+)
+
+// 속도 제한 관련 에러 코드
+const (
+	ErrCodeRateLimited = "rate_limited"
+	ErrCodeOverloaded  = "overloaded"
+)
+
+// rateLimiter 요청 속도 및 동시 처리 제한 관리자 (미사용 시 nil)
+var rateLimiter *ratelimit.Manager
+
+// clientLimitMiddleware 클라이언트 IP 속도 제한 및 동시 처리 상한 미들웨어
+// 인증 처리 비용이 들기 전에 차단하도록 인증 미들웨어보다 먼저 등록
+//
+// Returns:
+//   - gin.HandlerFunc: gin 미들웨어
+func (s *Server) clientLimitMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if rateLimiter == nil {
+			c.Next()
+			return
+		}
+
+		// 별칭 경로는 대응하는 /api/v1 라우트의 제한을 공유
+		route := canonicalRoute(c.FullPath())
+		if ok, wait := rateLimiter.AllowIP(route, c.ClientIP()); !ok {
+			abortRateLimited(c, wait, "request rate limit exceeded for this client")
+			return
+		}
+
+		release, ok := rateLimiter.Acquire(route)
+		if !ok {
+			c.Header("Retry-After", strconv.Itoa(config.Conf.RateLimit.RetryAfter))
+			abortWithError(c, http.StatusServiceUnavailable, ErrCodeOverloaded,
+				"too many requests in flight, retry later")
+			return
+		}
+		defer release()
+
+		c.Next()
+	}
+}
+
+// identityLimitMiddleware 인증 주체 속도 제한 미들웨어 (인증 미들웨어 다음에 등록)
+//
+// Returns:
+//   - gin.HandlerFunc: gin 미들웨어
+func (s *Server) identityLimitMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		identity := identityFrom(c)
+		if rateLimiter == nil || identity == nil {
+			c.Next()
+			return
+		}
+
+		if ok, wait := rateLimiter.AllowIdentity(canonicalRoute(c.FullPath()), identity.Method+":"+identity.Name); !ok {
+			abortRateLimited(c, wait, "request rate limit exceeded for "+identity.String())
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// abortRateLimited 429 응답 전송 (Retry-After 헤더 포함)
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+//   - wait: 다음 요청 가능 시점까지 대기 시간
+//   - detail: 상세 설명
+func abortRateLimited(c *gin.Context, wait time.Duration, detail string) {
+	c.Header("Retry-After", strconv.Itoa(int(math.Max(1, math.Ceil(wait.Seconds())))))
+	abortWithError(c, http.StatusTooManyRequests, ErrCodeRateLimited, detail)
+}
@@ -0,0 +1,112 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package server
+
+import (
+	"crypto/rand"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/meloncoffee/unisys/internal/logger"
+)
+
+// requestIDHeader 요청 ID 헤더 이름
+const requestIDHeader = "X-Request-ID"
+
+// requestIDKey gin 컨텍스트에 요청 ID를 저장하는 키
+const requestIDKey = "unisys.requestID"
+
+// maxRequestIDLength 클라이언트가 전달한 요청 ID의 최대 길이
+const maxRequestIDLength = 128
+
+// validRequestID 클라이언트가 전달한 요청 ID 사용 가능 여부 확인
+// 로그 위조를 막기 위해 영문, 숫자 및 일부 구분 문자(- _ . : /)만 허용
+//
+// Parameters:
+//   - id: 요청 ID
+//
+// Returns:
+//   - bool: 사용 가능(true), 사용 불가(false)
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, ch := range id {
+		switch {
+		case ch >= 'a' && ch <= 'z', ch >= 'A' && ch <= 'Z', ch >= '0' && ch <= '9':
+		case ch == '-', ch == '_', ch == '.', ch == ':', ch == '/':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// newRequestID 요청 ID 생성 (UUID v4 형식)
+//
+// Returns:
+//   - string: 요청 ID
+func newRequestID() string {
+	var b [16]byte
+	rand.Read(b[:])
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// requestIDMiddleware 요청 ID 설정 미들웨어
+// 요청의 X-Request-ID가 유효하면 사용하고 없으면 생성하여 응답 헤더로 반환
+//
+// Returns:
+//   - gin.HandlerFunc: gin 미들웨어
+func (s *Server) requestIDMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(requestIDHeader)
+		if !validRequestID(id) {
+			id = newRequestID()
+		}
+		c.Set(requestIDKey, id)
+		c.Header(requestIDHeader, id)
+		c.Next()
+	}
+}
+
+// requestIDFrom gin 컨텍스트에서 요청 ID 획득
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+//
+// Returns:
+//   - string: 요청 ID (없으면 빈 문자열)
+func requestIDFrom(c *gin.Context) string {
+	return c.GetString(requestIDKey)
+}
+
+// requestLogger 요청 ID가 포함된 로거 획득
+// 요청 처리 중 기록하는 로그는 이 로거를 사용하여 접근 로그와 연결
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+//
+// Returns:
+//   - logger.Logger: 로거
+func requestLogger(c *gin.Context) logger.Logger {
+	if id := requestIDFrom(c); id != "" {
+		return logger.Log.WithRequestID(id)
+	}
+	return logger.Log
+}
@@ -0,0 +1,219 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package server
+
+import (
+	"net/http"
+	"path"
+
+	"github.com/gin-gonic/gin"
+	"github.com/meloncoffee/unisys/config"
+	"github.com/meloncoffee/unisys/internal/auth"
+	"github.com/meloncoffee/unisys/internal/httpstats"
+)
+
+// apiBasePath 버전별 REST API 기준 경로
+const apiBasePath = "/api/v1"
+
+// apiRoute REST API 라우트 정의 구조체
+// 라우트 등록과 OpenAPI 문서 생성에 함께 사용됨
+type apiRoute struct {
+	method      string          // HTTP 메서드
+	path        string          // 기준 경로(apiBasePath) 하위 경로
+	operationID string          // OpenAPI operationId
+	summary     string          // 요약 설명
+	description string          // 상세 설명
+	tag         string          // OpenAPI 태그 (라우트 그룹)
+	scope       string          // 요구되는 권한 범위 (빈 문자열이면 인증 불필요)
+	handler     gin.HandlerFunc // 요청 핸들러
+	query       interface{}     // 쿼리 파라미터 구조체 (form 태그)
+	body        interface{}     // 요청 바디 구조체 (JSON)
+	status      int             // 성공 응답 상태 코드 (0이면 200)
+	response    interface{}     // 응답 구조체 (nil이면 문자열 응답)
+	contentType string          // 응답 Content-Type (빈 문자열이면 application/json)
+}
+
+// aliasRoute /api/v1 라우트의 별칭 경로 정의 구조체
+type aliasRoute struct {
+	path      string          // 별칭 경로
+	canonical string          // 대응하는 /api/v1 라우트 경로 (없으면 빈 문자열)
+	tag       string          // 라우트 그룹
+	scope     string          // 요구되는 권한 범위 (빈 문자열이면 인증 불필요)
+	handler   gin.HandlerFunc // 요청 핸들러
+}
+
+// canonicalRoutes 별칭 경로와 대응하는 /api/v1 라우트 경로
+// 라우트별 접근 규칙과 속도 제한을 별칭 경로에도 같게 적용하기 위해 사용
+var canonicalRoutes map[string]string
+
+// aliasRoutes 설정 가능한 경로 및 기존 경로의 별칭 정의 목록
+//
+// Returns:
+//   - []aliasRoute: 별칭 정의 목록
+func aliasRoutes() []aliasRoute {
+	return []aliasRoute{
+		{config.Conf.API.MetricURI, apiBasePath + "/metrics", "system", auth.ScopeMetricsRead, metricsHandler},
+		{config.Conf.API.HealthURI, apiBasePath + "/health", "system", "", healthHandler},
+		{config.Conf.API.SysStatURI, apiBasePath + "/sys/stats", "sys", auth.ScopeMetricsRead, sysStatsHandler},
+		{config.Conf.API.ForecastURI, apiBasePath + "/sys/forecast", "sys", auth.ScopeResourcesRead, forecastHandler},
+		{"/version", apiBasePath + "/version", "system", "", versionHandler},
+		{"/", "", "system", "", rootHandler},
+	}
+}
+
+// newCanonicalRoutes 별칭 경로와 /api/v1 라우트 경로의 대응 관계 생성
+// /api/v1 라우트와 같은 경로의 별칭은 등록되지 않으므로 제외
+//
+// Returns:
+//   - map[string]string: 별칭 경로와 /api/v1 라우트 경로
+func newCanonicalRoutes() map[string]string {
+	v1Paths := make(map[string]struct{})
+	for _, route := range apiV1Routes() {
+		v1Paths[path.Join(apiBasePath, route.path)] = struct{}{}
+	}
+
+	routes := make(map[string]string)
+	for _, alias := range aliasRoutes() {
+		if alias.canonical == "" {
+			continue
+		}
+		if _, ok := v1Paths[alias.path]; ok {
+			continue
+		}
+		if _, ok := routes[alias.path]; !ok {
+			routes[alias.path] = alias.canonical
+		}
+	}
+	return routes
+}
+
+// canonicalRoute 라우트 경로를 /api/v1 라우트 경로로 변환 (별칭이 아니면 그대로 반환)
+//
+// Parameters:
+//   - route: 라우트 경로
+//
+// Returns:
+//   - string: /api/v1 라우트 경로
+func canonicalRoute(route string) string {
+	if canonical, ok := canonicalRoutes[route]; ok {
+		return canonical
+	}
+	return route
+}
+
+// apiV1Routes /api/v1 라우트 정의 목록
+//
+// Returns:
+//   - []apiRoute: 라우트 정의 목록
+func apiV1Routes() []apiRoute {
+	return []apiRoute{
+		{
+			method: http.MethodGet, path: "/health", operationID: "getHealth",
+			summary: "Health check", tag: "system",
+			handler: healthHandler, response: HealthResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/version", operationID: "getVersion",
+			summary: "Version information", tag: "system",
+			handler: versionHandler, response: VersionResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/metrics", operationID: "getMetrics",
+			summary: "Prometheus metrics", tag: "system", scope: auth.ScopeMetricsRead,
+			handler: metricsHandler, contentType: "text/plain; version=0.0.4",
+		},
+		{
+			method: http.MethodGet, path: "/openapi.json", operationID: "getOpenAPI",
+			summary: "OpenAPI 3 document for this API", tag: "system",
+			handler: openAPIHandler, response: map[string]interface{}{},
+		},
+		{
+			method: http.MethodPost, path: "/login", operationID: "login",
+			summary: "Log in with username and password",
+			description: "Sets an HttpOnly session cookie and a CSRF cookie. Requests authenticated by " +
+				"the session cookie must echo the CSRF token in the X-CSRF-Token header on unsafe methods.",
+			tag: "auth", handler: loginHandler, body: LoginRequest{}, response: LoginResponse{},
+		},
+		{
+			method: http.MethodPost, path: "/logout", operationID: "logout",
+			summary: "End the current session", tag: "auth",
+			handler: logoutHandler, status: http.StatusNoContent,
+		},
+		{
+			method: http.MethodGet, path: "/session", operationID: "getSession",
+			summary: "Identity of the current caller", tag: "auth",
+			handler: sessionHandler, response: SessionResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/oidc/login", operationID: "oidcLogin",
+			summary: "Start OpenID Connect single sign-on",
+			description: "Redirects the browser to the identity provider " +
+				"(authorization code flow with PKCE).",
+			tag: "auth", handler: oidcLoginHandler, status: http.StatusFound,
+		},
+		{
+			method: http.MethodGet, path: "/oidc/callback", operationID: "oidcCallback",
+			summary: "OpenID Connect redirect target",
+			description: "Validates the ID token, starts a session (same cookies as /login) and " +
+				"forwards the browser to auth.oidc.postLoginURL.",
+			tag: "auth", handler: oidcCallbackHandler, query: OIDCCallbackQuery{}, contentType: "text/html",
+		},
+		{
+			method: http.MethodGet, path: "/sys/stats", operationID: "getSysStats",
+			summary: "HTTP request statistics", tag: "sys", scope: auth.ScopeMetricsRead,
+			handler: sysStatsHandler, response: httpstats.Data{},
+		},
+		{
+			method: http.MethodGet, path: "/sys/forecast", operationID: "getForecast",
+			summary: "Disk and memory time-to-full forecast", tag: "sys", scope: auth.ScopeResourcesRead,
+			handler: forecastHandler, response: ForecastResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/resources", operationID: "getResources",
+			summary: "Latest snapshot of all collected resources", tag: "resources", scope: auth.ScopeResourcesRead,
+			handler: resourcesHandler, response: ResourcesResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/resources/cpu", operationID: "getCPUResource",
+			summary: "Latest CPU usage", tag: "resources", scope: auth.ScopeResourcesRead,
+			handler: cpuResourceHandler, response: CPUResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/resources/memory", operationID: "getMemoryResource",
+			summary: "Latest memory usage", tag: "resources", scope: auth.ScopeResourcesRead,
+			handler: memoryResourceHandler, response: MemoryResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/resources/disks", operationID: "getDisksResource",
+			summary: "Latest disk usage per mountpoint", tag: "resources", scope: auth.ScopeResourcesRead,
+			handler: disksResourceHandler, response: DisksResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/resources/network", operationID: "getNetworkResource",
+			summary: "Latest network traffic per interface", tag: "resources", scope: auth.ScopeResourcesRead,
+			handler: networkResourceHandler, response: NetworkResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/stream", operationID: "streamResources",
+			summary: "Live resource samples",
+			description: "Server-Sent Events stream of StreamEvent objects (event: resource). " +
+				"Requests with a WebSocket upgrade receive the same objects as text messages.",
+			tag: "resources", scope: auth.ScopeResourcesRead, handler: streamHandler, query: StreamQuery{},
+			response: StreamEvent{}, contentType: "text/event-stream",
+		},
+	}
+}
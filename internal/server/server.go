@@ -21,27 +21,33 @@ package server
 
 import (
 	"context"
-	"crypto/tls"
+	"fmt"
+	"net"
 	"net/http"
-	"strconv"
+	"path"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/meloncoffee/unisys/config"
+	"github.com/meloncoffee/unisys/internal/auth"
+	"github.com/meloncoffee/unisys/internal/certs"
+	"github.com/meloncoffee/unisys/internal/httpstats"
 	"github.com/meloncoffee/unisys/internal/logger"
 	"github.com/meloncoffee/unisys/internal/metric"
+	"github.com/meloncoffee/unisys/internal/ratelimit"
 	"github.com/meloncoffee/unisys/pkg/util/process"
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/thoas/stats"
+	"golang.org/x/crypto/acme"
 	"golang.org/x/crypto/acme/autocert"
 )
 
 var doOnce sync.Once
 
-// 서버 응답 시간 및 상태 코드 카운트
-var servStats *stats.Stats
+// 라우트별 HTTP 요청 메트릭 및 요청 통계 요약
+var servStats *httpstats.Recorder
 
 // Server 메인 서버 정보 구조체
 type Server struct{}
@@ -52,102 +58,174 @@ type Server struct{}
 //   - ctx: 종료 컨텍스트
 func (s *Server) Run(ctx context.Context) {
 	var err error
-	isTLS := true
-	port := config.Conf.Server.Port
-
-	// 서버 공통 설정
-	server := &http.Server{
-		// gin 엔진 설정
-		Handler: s.newGinRouterEngine(),
-		// 요청 타임아웃 10초 설정
-		ReadTimeout: 10 * time.Second,
-		// 응답 타임아웃 10초 설정
-		WriteTimeout: 10 * time.Second,
-		// 요청 헤더 최대 크기를 1MB로 설정
-		MaxHeaderBytes: 1 << 20,
+	var certStore *certs.Store
+	var acmeManager *autocert.Manager
+	var acmeServer *http.Server
+
+	// 서버 종료 시 스트리밍 연결도 함께 종료되도록 설정
+	streamCtx = ctx
+
+	// 신뢰 프록시 및 IP 접근 규칙 유효성 검사
+	if _, err := parsePrefixes(config.Conf.Server.TrustedProxies); err != nil {
+		logger.Log.LogError("invalid trustedProxies: %v", err)
+		process.SendSignal(config.RunConf.Pid, syscall.SIGUSR1)
+		return
+	}
+	// 사용자 정의 역할 및 역할 매핑 유효성 검사
+	if err := auth.ValidateRoleConfig(); err != nil {
+		logger.Log.LogError("invalid rbac config: %v", err)
+		process.SendSignal(config.RunConf.Pid, syscall.SIGUSR1)
+		return
+	}
+	canonicalRoutes = newCanonicalRoutes()
+	accessFilter, err = newIPFilter()
+	if err != nil {
+		logger.Log.LogError("invalid ip access rules: %v", err)
+		process.SendSignal(config.RunConf.Pid, syscall.SIGUSR1)
+		return
 	}
 
-	// Auto TLS 사용 옵션 체크
-	if config.Conf.Server.AutoTLS.Enabled {
-		// Auto TLS 옵션 유효성 검사
-		if config.Conf.Server.AutoTLS.Host == "" ||
-			config.Conf.Server.AutoTLS.CertPath == "" {
-			logger.Log.LogError("invalid auto TLS options (host:%s, cert path: %s)",
-				config.Conf.Server.AutoTLS.Host, config.Conf.Server.AutoTLS.CertPath)
+	// 리스너 설정 해석
+	var listeners []*listener
+	useTLS := false
+	for _, conf := range listenerConfigs() {
+		l, err := newListener(conf)
+		if err != nil {
+			logger.Log.LogError("invalid listener %s: %v", conf.Name, err)
 			process.SendSignal(config.RunConf.Pid, syscall.SIGUSR1)
 			return
 		}
+		listeners = append(listeners, l)
+		useTLS = useTLS || l.tls
+	}
 
-		// Let's Encrypt 기반의 auto TLS(HTTPS) 구성
-		m := autocert.Manager{
-			Prompt:     autocert.AcceptTOS,
-			HostPolicy: autocert.HostWhitelist(config.Conf.Server.AutoTLS.Host),
-			Cache:      autocert.DirCache(config.Conf.Server.AutoTLS.CertPath),
-		}
-
-		// 서버 주소 및 TLS 설정
-		server.Addr = ":https"
-		server.TLSConfig = &tls.Config{GetCertificate: m.GetCertificate}
-		port = 443
-	} else if config.Conf.Server.TLSEnabled {
-		// TLS 인증서 파일 옵션 유효성 검사
-		if config.Conf.Server.TLSCertificateFile == "" ||
-			config.Conf.Server.TLSPrivateKeyFile == "" {
-			logger.Log.LogError("invalid TLS certificate files (cert: %s, private key: %s)",
-				config.Conf.Server.TLSCertificateFile, config.Conf.Server.TLSPrivateKeyFile)
+	// TLS 리스너가 사용할 인증서 구성
+	if useTLS && config.Conf.Server.AutoTLS.Enabled {
+		// ACME 기반의 auto TLS(HTTPS) 구성
+		if acmeManager, err = newACMEManager(); err != nil {
+			logger.Log.LogError("invalid auto TLS options: %v", err)
 			process.SendSignal(config.RunConf.Pid, syscall.SIGUSR1)
 			return
 		}
 
-		// TLS 설정
-		tlsConf := &tls.Config{
-			// 최소 TLS 지원 버전 설정
-			MinVersion: tls.VersionTLS12,
+		// HTTP-01 챌린지 및 HTTPS 리다이렉트 서버 설정
+		if config.Conf.Server.AutoTLS.HTTPPort > 0 {
+			acmeServer = newACMEHTTPServer(acmeManager, httpsPort(listeners))
 		}
-		if tlsConf.NextProtos == nil {
-			// 애플리케이션 계층 프로토콜(HTTP/1.1, HTTP/2) 설정
-			tlsConf.NextProtos = []string{"h2", "http/1.1"}
+	} else if useTLS {
+		// 기본 인증서 파일이 없으면 자체 서명 인증서 생성
+		if config.Conf.Server.TLSAutoGenerate {
+			cert, err := certs.EnsureSelfSigned(config.Conf.Server.TLSCertificateFile,
+				config.Conf.Server.TLSPrivateKeyFile)
+			if err != nil {
+				logger.Log.LogError("failed to generate self-signed certificate: %v", err)
+				process.SendSignal(config.RunConf.Pid, syscall.SIGUSR1)
+				return
+			}
+			if cert != nil {
+				logger.Log.LogWarn("generated self-signed certificate %s (names: %s), clients will not trust it",
+					config.Conf.Server.TLSCertificateFile, strings.Join(cert.DNSNames, ", "))
+			}
+		}
+
+		// TLS 인증서 파일 목록 (기본 인증서 + SNI 추가 인증서)
+		var pairs []certs.Pair
+		if config.Conf.Server.TLSCertificateFile != "" || config.Conf.Server.TLSPrivateKeyFile != "" {
+			pairs = append(pairs, certs.Pair{
+				CertFile: config.Conf.Server.TLSCertificateFile,
+				KeyFile:  config.Conf.Server.TLSPrivateKeyFile,
+			})
+		}
+		for _, c := range config.Conf.Server.TLSCertificates {
+			pairs = append(pairs, certs.Pair{CertFile: c.CertFile, KeyFile: c.KeyFile})
 		}
 
 		// TLS 인증서 로드
-		tlsConf.Certificates = make([]tls.Certificate, 1)
-		tlsConf.Certificates[0], err = tls.LoadX509KeyPair(config.Conf.Server.TLSCertificateFile,
-			config.Conf.Server.TLSPrivateKeyFile)
+		certStore, err = certs.NewStore(pairs)
 		if err != nil {
-			logger.Log.LogError("failed to load https cert file: %v", err)
+			logger.Log.LogError("failed to load https cert file: %v "+
+				"(create one with 'unisys cert selfsigned' or enable tlsAutoGenerate)", err)
 			process.SendSignal(config.RunConf.Pid, syscall.SIGUSR1)
 			return
 		}
+	}
 
-		// 서버 주소 및 TLS 설정
-		server.Addr = ":" + strconv.Itoa(port)
-		server.TLSConfig = tlsConf
-	} else {
-		// 서버 주소 설정 (TLS 사용 안함)
-		server.Addr = ":" + strconv.Itoa(port)
-		isTLS = false
+	// 리스너별 HTTP 서버 구성
+	for _, l := range listeners {
+		if err := s.configureListener(l, acmeManager, certStore); err != nil {
+			logger.Log.LogError("invalid listener %s: %v", l.conf.Name, err)
+			process.SendSignal(config.RunConf.Pid, syscall.SIGUSR1)
+			return
+		}
 	}
 
-	// HTTP 서버 가동
-	if isTLS {
+	// 리슨 시작 (실패 시 이미 생성한 리스너 정리)
+	for i, l := range listeners {
+		if err := l.listen(); err != nil {
+			logger.Log.LogError("failed to listen on %s: %v", l.displayAddress(), err)
+			for _, opened := range listeners[:i] {
+				opened.close()
+			}
+			process.SendSignal(config.RunConf.Pid, syscall.SIGUSR1)
+			return
+		}
+	}
+
+	// HTTP-01 챌린지 서버 가동
+	if acmeServer != nil {
+		acmeLn, err := net.Listen("tcp", acmeServer.Addr)
+		if err != nil {
+			logger.Log.LogError("failed to listen on %s for acme http challenges: %v", acmeServer.Addr, err)
+			for _, l := range listeners {
+				l.close()
+			}
+			process.SendSignal(config.RunConf.Pid, syscall.SIGUSR1)
+			return
+		}
 		go func() {
-			err := server.ListenAndServeTLS("", "")
-			if err != nil && err != http.ErrServerClosed {
-				logger.Log.LogError("server error occurred: %v", err)
-				process.SendSignal(config.RunConf.Pid, syscall.SIGUSR1)
+			if err := acmeServer.Serve(acmeLn); err != nil && err != http.ErrServerClosed {
+				logger.Log.LogError("acme http server error occurred: %v", err)
 			}
 		}()
-	} else {
-		go func() {
-			err := server.ListenAndServe()
+		defer acmeServer.Close()
+		logger.Log.LogInfo("acme http challenge server listening on %s (hosts: %s)",
+			acmeServer.Addr, strings.Join(acmeHosts(), ", "))
+	}
+
+	// 인증서 파일 변경 감시 (cert-manager 등의 인증서 교체를 재시작 없이 반영)
+	if certStore != nil {
+		go certStore.Watch(ctx, time.Duration(config.Conf.Server.TLSReloadInterval)*time.Second)
+	}
+
+	// HTTP 서버 가동
+	for _, l := range listeners {
+		go func(l *listener) {
+			var err error
+			if l.tls {
+				err = l.server.ServeTLS(l.ln, "", "")
+			} else {
+				err = l.server.Serve(l.ln)
+			}
 			if err != nil && err != http.ErrServerClosed {
-				logger.Log.LogError("server error occurred: %v", err)
+				logger.Log.LogError("server error occurred on %s: %v", l.displayAddress(), err)
 				process.SendSignal(config.RunConf.Pid, syscall.SIGUSR1)
 			}
-		}()
-	}
+		}(l)
 
-	logger.Log.LogInfo("server listening on port %d", port)
+		logger.Log.LogInfo("server listener %s listening on %s (tls: %t, routes: %s)",
+			l.conf.Name, l.displayAddress(), l.tls, strings.Join(l.conf.Routes, ", "))
+
+		// HTTP/3 서버 가동
+		if l.h3 != nil {
+			go func(l *listener) {
+				if err := l.h3.Serve(l.packetConn); err != nil && err != http.ErrServerClosed {
+					logger.Log.LogError("http3 server error occurred on %s: %v", l.displayAddress(), err)
+				}
+			}(l)
+
+			logger.Log.LogInfo("server listener %s serving http3 on udp %s", l.conf.Name, l.packetConn.LocalAddr())
+		}
+	}
 
 	// 서버 종료 신호 대기
 	<-ctx.Done()
@@ -157,28 +235,145 @@ func (s *Server) Run(ctx context.Context) {
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
-	// 서버 종료
-	err = server.Shutdown(shutdownCtx)
+	// 모든 리스너의 서버를 동시에 종료
+	var wg sync.WaitGroup
+	for _, l := range listeners {
+		wg.Add(1)
+		go func(l *listener) {
+			defer wg.Done()
+			if l.h3 != nil {
+				if err := l.h3.Shutdown(shutdownCtx); err != nil {
+					logger.Log.LogWarn("http3 server shutdown on %s: %v", l.displayAddress(), err)
+				}
+				l.packetConn.Close()
+			}
+			if err := l.server.Shutdown(shutdownCtx); err != nil {
+				logger.Log.LogWarn("server shutdown on %s: %v", l.displayAddress(), err)
+				return
+			}
+			logger.Log.LogInfo("server shutdown on %s", l.displayAddress())
+		}(l)
+	}
+	wg.Wait()
+}
+
+// configureListener 리스너의 HTTP 서버 및 TLS 설정 구성
+//
+// Parameters:
+//   - l: 리스너 정보
+//   - acmeManager: ACME 인증서 관리자 (autoTLS 미사용 시 nil)
+//   - certStore: TLS 인증서 저장소 (autoTLS 사용 시 nil)
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func (s *Server) configureListener(l *listener, acmeManager *autocert.Manager, certStore *certs.Store) error {
+	l.server = &http.Server{
+		// gin 엔진 설정 (리스너가 제공하는 라우트만 등록)
+		Handler: s.newGinRouterEngine(l),
+		// 요청 타임아웃 설정
+		ReadTimeout: time.Duration(config.Conf.Server.ReadTimeout) * time.Second,
+		// 응답 타임아웃 설정
+		WriteTimeout: time.Duration(config.Conf.Server.WriteTimeout) * time.Second,
+		// 요청 헤더 최대 크기 설정
+		MaxHeaderBytes: config.Conf.Server.MaxHeaderBytes,
+		// 요청 컨텍스트에 리스너 정보 저장
+		BaseContext: func(net.Listener) context.Context {
+			return context.WithValue(context.Background(), listenerKey{}, l)
+		},
+	}
+	if l.network == "unix" {
+		// 유닉스 소켓 접속 프로세스 자격 증명 저장
+		l.server.ConnContext = peerCredContext
+	}
+	if !l.tls {
+		return nil
+	}
+
+	// TLS 정책(버전, 암호 스위트, 곡선, HTTP/2) 설정
+	tlsConf, err := newTLSConfig()
 	if err != nil {
-		logger.Log.LogWarn("server shutdown: %v", err)
-		return
+		return fmt.Errorf("invalid TLS policy: %v", err)
+	}
+	if acmeManager != nil {
+		// ACME 인증서 사용 (TLS-ALPN-01 챌린지 프로토콜 포함)
+		tlsConf.GetCertificate = acmeManager.GetCertificate
+		tlsConf.NextProtos = append(tlsConf.NextProtos, acme.ALPNProto)
+	} else {
+		// SNI 서버 이름에 맞는 인증서 선택
+		tlsConf.GetCertificate = certStore.GetCertificate
+	}
+
+	// 클라이언트 인증서(mTLS) 설정
+	if l.clientCAPool, err = configureClientAuth(tlsConf, l.conf.ClientAuth, l.conf.ClientCAFile); err != nil {
+		return fmt.Errorf("invalid client auth options: %v", err)
+	}
+	l.server.TLSConfig = tlsConf
+
+	// HTTP/2 설정
+	if err := configureHTTP2(l.server); err != nil {
+		return fmt.Errorf("invalid http2 options: %v", err)
 	}
 
-	logger.Log.LogInfo("server shutdown on port %d", port)
+	// HTTP/3(QUIC) 설정 (TCP 리스너와 같은 주소의 UDP 포트 사용)
+	if config.Conf.Server.HTTP3.Enabled && l.network == "tcp" {
+		if err := l.configureHTTP3(tlsConf); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // newRouterEngine gin 엔진 생성
 //
+// Parameters:
+//   - l: 리스너 정보 (리스너가 제공하는 라우트만 등록)
+//
 // Returns:
 //   - *gin.Engine: gin 엔진
-func (s *Server) newGinRouterEngine() *gin.Engine {
+func (s *Server) newGinRouterEngine(l *listener) *gin.Engine {
 	// 런타임 중 한번만 호출됨
 	doOnce.Do(func() {
-		// Stats 구조체 생성
-		servStats = stats.New()
+		// 라우트별 HTTP 요청 메트릭 생성
+		servStats = httpstats.NewRecorder(config.Conf.HTTPMetrics.DurationBuckets,
+			config.Conf.HTTPMetrics.SizeBuckets)
 		// Prometheus 메트릭 등록
 		m := metric.NewMetrics()
-		prometheus.MustRegister(m)
+		prometheus.MustRegister(m, servStats)
+		// 요청 값 공용 검증 규칙 등록
+		registerValidations()
+		// API 토큰 저장소 로드
+		tokenStore = auth.NewTokenStore(config.Conf.Auth.TokenFile)
+		if err := tokenStore.Load(); err != nil {
+			logger.Log.LogError("failed to load token file: %v", err)
+		}
+		// 로그인 사용자 저장소 로드 및 세션 관리자 생성
+		userStore = auth.NewUserStore(config.Conf.Auth.UserFile)
+		if err := userStore.Load(); err != nil {
+			logger.Log.LogError("failed to load user file: %v", err)
+		}
+		sessionStore = auth.NewSessionStore(
+			time.Duration(config.Conf.Auth.SessionIdleTimeout)*time.Second,
+			time.Duration(config.Conf.Auth.SessionMaxAge)*time.Second, userStore)
+		loginLimiter = auth.NewLoginLimiter(config.Conf.Auth.MaxLoginFailures,
+			time.Duration(config.Conf.Auth.LockoutDuration)*time.Second)
+		// OIDC 발급자 연동 생성 (discovery는 백그라운드에서 미리 수행)
+		if config.Conf.Auth.OIDC.Enabled {
+			provider, err := auth.NewOIDCProvider()
+			if err != nil {
+				logger.Log.LogError("oidc disabled, invalid config: %v", err)
+			} else {
+				oidcProvider = provider
+				go func() {
+					if err := provider.Discover(context.Background()); err != nil {
+						logger.Log.LogWarn("%v (will retry on first use)", err)
+					}
+				}()
+			}
+		}
+		// 요청 속도 및 동시 처리 제한 관리자 생성
+		if config.Conf.RateLimit.Enabled {
+			rateLimiter = ratelimit.NewManager(canonicalRoute)
+		}
 	})
 
 	// gin 모드 설정
@@ -192,21 +387,60 @@ func (s *Server) newGinRouterEngine() *gin.Engine {
 	// gin 라우터 생성
 	r := gin.New()
 
-	// 복구 미들웨어 등록
-	r.Use(gin.Recovery())
+	// 신뢰 프록시 설정 (설정이 없으면 프록시 헤더를 무시하고 접속 주소를 클라이언트 IP로 사용)
+	if err := r.SetTrustedProxies(trustedProxies()); err != nil {
+		logger.Log.LogError("failed to set trusted proxies: %v", err)
+	}
+
+	// 404/405 응답을 problem+json 형식으로 처리
+	r.HandleMethodNotAllowed = true
+	r.NoRoute(noRouteHandler)
+	r.NoMethod(noMethodHandler)
+
+	// 요청 ID 미들웨어 등록 (이후 미들웨어와 핸들러의 로그에 요청 ID 포함)
+	r.Use(s.requestIDMiddleware())
+	// 복구 미들웨어 등록 (패닉 발생 시 problem+json 500 응답)
+	r.Use(gin.CustomRecovery(recoveryHandler))
 	// 요청/응답 정보 로깅 미들웨어 등록
 	r.Use(s.ginLoggerMiddleware())
 	// 버전 정보 미들웨어 등록
 	r.Use(s.versionMiddleware())
 	// 요청 통계를 수집하고 기록하는 미들웨어 등록
 	r.Use(s.statMiddleware())
+	// 클라이언트 IP 접근 제어 미들웨어 등록
+	r.Use(s.accessMiddleware())
+	// 클라이언트 IP 속도 제한 및 동시 처리 상한 미들웨어 등록
+	r.Use(s.clientLimitMiddleware())
+	// 변경 요청 감사 로그 미들웨어 등록
+	r.Use(s.auditMiddleware())
+	// 자격 증명 확인 미들웨어 등록
+	r.Use(s.authMiddleware())
+	// 인증 주체 속도 제한 미들웨어 등록
+	r.Use(s.identityLimitMiddleware())
+
+	// 버전별 REST API 핸들러 등록
+	registered := make(map[string]struct{})
+	v1 := r.Group(apiBasePath)
+	for _, route := range apiV1Routes() {
+		if !l.serves(route.tag, path.Join(apiBasePath, route.path)) {
+			continue
+		}
+		v1.Handle(route.method, route.path, requireScope(route.scope), route.handler)
+		registered[route.method+" "+path.Join(apiBasePath, route.path)] = struct{}{}
+	}
 
-	// 요청 핸들러 등록
-	r.GET(config.Conf.API.MetricURI, metricsHandler)
-	r.GET(config.Conf.API.HealthURI, healthHandler)
-	r.GET(config.Conf.API.SysStatURI, sysStatsHandler)
-	r.GET("/version", versionHandler)
-	r.GET("/", rootHandler)
+	// 설정 가능한 경로 및 기존 경로를 /api/v1 핸들러의 별칭으로 등록
+	for _, alias := range aliasRoutes() {
+		if !l.serves(alias.tag, alias.path) {
+			continue
+		}
+		// 이미 등록된 경로와 중복되면 gin이 패닉을 발생시키므로 건너뜀
+		if _, ok := registered[http.MethodGet+" "+alias.path]; ok {
+			continue
+		}
+		r.GET(alias.path, requireScope(alias.scope), alias.handler)
+		registered[http.MethodGet+" "+alias.path] = struct{}{}
+	}
 
 	return r
 }
@@ -220,6 +454,8 @@ func (s *Server) ginLoggerMiddleware() gin.HandlerFunc {
 	excludePath := map[string]struct{}{
 		config.Conf.API.MetricURI: {},
 		config.Conf.API.HealthURI: {},
+		apiBasePath + "/metrics":  {},
+		apiBasePath + "/health":   {},
 	}
 
 	return func(c *gin.Context) {
@@ -240,39 +476,31 @@ func (s *Server) ginLoggerMiddleware() gin.HandlerFunc {
 			return
 		}
 
-		// 요청 종료 시간 및 latency 계산
-		end := time.Now()
-		latency := end.Sub(start)
-
-		// 로그 메시지 설정
-		var logMsg string
-		if len(c.Errors) > 0 {
-			logMsg = c.Errors.String()
-		} else {
-			logMsg = "Request"
+		entry := logger.AccessEntry{
+			Time:      start,
+			RequestID: requestIDFrom(c),
+			RemoteIP:  c.ClientIP(),
+			Method:    c.Request.Method,
+			Path:      path,
+			Route:     c.FullPath(),
+			Proto:     c.Request.Proto,
+			Status:    c.Writer.Status(),
+			Bytes:     c.Writer.Size(),
+			Duration:  time.Since(start),
+			Identity:  identityFrom(c).String(),
+			UserAgent: c.Request.UserAgent(),
 		}
-		// 상태 코드 획득
-		statusCode := c.Writer.Status()
-		// 요청 메서드 획득
-		method := c.Request.Method
-		// 요청 클라이언트 IP 획득
-		clientIP := c.ClientIP()
-		// 사용자 에이전트 획득
-		userAgent := c.Request.UserAgent()
-		// 응답 바디 사이즈 획득
-		resBodySize := c.Writer.Size()
-
-		// 로그 출력 (상태 코드에 따른 로그 레벨 설정)
-		if statusCode >= 500 {
-			logger.Log.LogError("[%d] %s %s (IP: %s, Latency: %v, UA: %s, ResSize: %d) %s",
-				statusCode, method, path, clientIP, latency, userAgent, resBodySize, logMsg)
-		} else if statusCode >= 400 {
-			logger.Log.LogWarn("[%d] %s %s (IP: %s, Latency: %v, UA: %s, ResSize: %d) %s",
-				statusCode, method, path, clientIP, latency, userAgent, resBodySize, logMsg)
-		} else {
-			logger.Log.LogInfo("[%d] %s %s (IP: %s, Latency: %v, UA: %s, ResSize: %d) %s",
-				statusCode, method, path, clientIP, latency, userAgent, resBodySize, logMsg)
+		// 응답 바디가 없으면 -1이므로 0으로 보정
+		if entry.Bytes < 0 {
+			entry.Bytes = 0
 		}
+		// 처리 중 발생한 에러
+		if len(c.Errors) > 0 {
+			entry.Error = c.Errors.String()
+		}
+
+		// 접근 로그 출력 (형식 및 출력 파일은 accessLog 설정에 따름)
+		logger.Log.LogAccess(entry)
 	}
 }
 
@@ -293,8 +521,10 @@ func (s *Server) versionMiddleware() gin.HandlerFunc {
 //   - gin.HandlerFunc: gin 미들웨어
 func (s *Server) statMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		beginning, recorder := servStats.Begin(c.Writer)
+		// 라우트 템플릿(예: /api/v1/resources/:name) 단위로 기록하여 레이블 수 제한
+		route := c.FullPath()
+		start := servStats.Begin(route)
 		c.Next()
-		servStats.End(beginning, stats.WithRecorder(recorder))
+		servStats.End(route, c.Request.Method, c.Writer.Status(), c.Writer.Size(), start)
 	}
 }
@@ -0,0 +1,280 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/meloncoffee/unisys/config"
+	"github.com/meloncoffee/unisys/internal/resourcecollecter"
+)
+
+// 스트리밍 토픽 (수집기 종류)
+const (
+	topicCPU     = "cpu"
+	topicMemory  = "memory"
+	topicDisks   = "disks"
+	topicNetwork = "network"
+)
+
+// StreamEvent 스트리밍으로 전달되는 리소스 샘플 구조체
+type StreamEvent struct {
+	CollectedAt time.Time        `json:"collectedAt"`       // 리소스 수집 시간
+	CPU         *CPUResponse     `json:"cpu,omitempty"`     // topics에 cpu 포함 시
+	Memory      *MemoryResponse  `json:"memory,omitempty"`  // topics에 memory 포함 시
+	Disks       *DisksResponse   `json:"disks,omitempty"`   // topics에 disks 포함 시
+	Network     *NetworkResponse `json:"network,omitempty"` // topics에 network 포함 시
+	Dropped     uint64           `json:"dropped,omitempty"` // 이전 이벤트 이후 클라이언트 지연으로 버려진 샘플 수
+}
+
+// StreamQuery 스트리밍 요청 쿼리 파라미터 구조체
+type StreamQuery struct {
+	Topics string `form:"topics" binding:"omitempty,topics" description:"Comma separated topics to include (cpu, memory, disks, network). Defaults to all."`
+}
+
+// streamTopics 구독할 토픽 집합
+type streamTopics map[string]struct{}
+
+// streamCtx 서버 종료 시 열려 있는 스트림을 닫기 위한 컨텍스트
+// (http.Server.Shutdown은 활성 연결의 요청 컨텍스트를 취소하지 않음)
+var streamCtx = context.Background()
+
+// wsUpgrader WebSocket 업그레이더
+var wsUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	// 업그레이드 실패 응답도 problem+json 형식으로 전송
+	Error: func(w http.ResponseWriter, r *http.Request, status int, reason error) {
+		w.Header().Set("Content-Type", problemContentType)
+		w.Header().Set("Sec-Websocket-Version", "13")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(Problem{
+			Type:     problemTypePrefix + ErrCodeBadRequest,
+			Title:    http.StatusText(status),
+			Status:   status,
+			Code:     ErrCodeBadRequest,
+			Detail:   reason.Error(),
+			Instance: r.URL.Path,
+		})
+	},
+}
+
+// parseStreamTopics 쿼리 문자열에서 구독 토픽 파싱 (미지정 시 전체 토픽)
+//
+// Parameters:
+//   - raw: 콤마로 구분된 토픽 목록
+//
+// Returns:
+//   - streamTopics: 구독할 토픽 집합
+//   - error: 성공(nil), 알 수 없는 토픽 포함(error)
+func parseStreamTopics(raw string) (streamTopics, error) {
+	topics := make(streamTopics)
+	if raw == "" {
+		raw = strings.Join([]string{topicCPU, topicMemory, topicDisks, topicNetwork}, ",")
+	}
+
+	for _, topic := range strings.Split(raw, ",") {
+		topic = strings.TrimSpace(topic)
+		switch topic {
+		case topicCPU, topicMemory, topicDisks, topicNetwork:
+			topics[topic] = struct{}{}
+		case "":
+		default:
+			return nil, fmt.Errorf("unknown topic: %s", topic)
+		}
+	}
+
+	return topics, nil
+}
+
+// newStreamEvent 리소스 정보를 구독 토픽에 맞는 스트리밍 이벤트로 변환
+//
+// Parameters:
+//   - res: 리소스 정보 구조체
+//   - topics: 구독할 토픽 집합
+//   - dropped: 버려진 샘플 수
+//
+// Returns:
+//   - StreamEvent: 스트리밍 이벤트
+func newStreamEvent(res resourcecollecter.Resource, topics streamTopics, dropped uint64) StreamEvent {
+	snapshot := newResourcesResponse(res)
+	event := StreamEvent{
+		CollectedAt: snapshot.CollectedAt,
+		Dropped:     dropped,
+	}
+
+	if _, ok := topics[topicCPU]; ok {
+		event.CPU = &snapshot.CPU
+	}
+	if _, ok := topics[topicMemory]; ok {
+		event.Memory = &snapshot.Memory
+	}
+	if _, ok := topics[topicDisks]; ok {
+		event.Disks = &snapshot.Disks
+	}
+	if _, ok := topics[topicNetwork]; ok {
+		event.Network = &snapshot.Network
+	}
+
+	return event
+}
+
+// streamHandler 리소스 샘플 실시간 스트리밍 핸들러
+// WebSocket 업그레이드 요청이면 WebSocket으로, 그 외에는 SSE로 전송
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+func streamHandler(c *gin.Context) {
+	var query StreamQuery
+	if !bindQuery(c, &query) {
+		return
+	}
+
+	// 검증 규칙(topics)을 통과했으므로 파싱 에러가 발생하지 않음
+	topics, _ := parseStreamTopics(query.Topics)
+
+	if websocket.IsWebSocketUpgrade(c.Request) {
+		streamWebSocket(c, topics)
+	} else {
+		streamSSE(c, topics)
+	}
+}
+
+// streamSSE Server-Sent Events로 리소스 샘플 전송
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+//   - topics: 구독할 토픽 집합
+func streamSSE(c *gin.Context, topics streamTopics) {
+	// 서버 WriteTimeout에 의해 스트림이 끊기지 않도록 쓰기 데드라인 해제
+	rc := http.NewResponseController(c.Writer)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		requestLogger(c).LogWarn("failed to clear write deadline: %v", err)
+	}
+
+	sub := resourcecollecter.Subscribe(config.Conf.Stream.BufferSize)
+	defer resourcecollecter.Unsubscribe(sub)
+
+	heartbeat := time.NewTicker(time.Duration(config.Conf.Stream.HeartbeatInterval) * time.Second)
+	defer heartbeat.Stop()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			// 클라이언트 연결 종료
+			return
+		case <-streamCtx.Done():
+			// 서버 종료
+			return
+		case res, ok := <-sub.C():
+			if !ok {
+				return
+			}
+			c.SSEvent("resource", newStreamEvent(res, topics, sub.Dropped()))
+		case <-heartbeat.C:
+			// SSE 주석 라인으로 하트비트 전송
+			if _, err := c.Writer.WriteString(": heartbeat\n\n"); err != nil {
+				return
+			}
+		}
+		c.Writer.Flush()
+	}
+}
+
+// streamWebSocket WebSocket으로 리소스 샘플 전송
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+//   - topics: 구독할 토픽 집합
+func streamWebSocket(c *gin.Context, topics streamTopics) {
+	conn, err := wsUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// 업그레이더가 이미 에러 응답(problem+json)을 전송함
+		requestLogger(c).LogWarn("failed to upgrade websocket: %v", err)
+		return
+	}
+	defer conn.Close()
+
+	interval := time.Duration(config.Conf.Stream.HeartbeatInterval) * time.Second
+	// 하트비트 응답(pong)이 두 주기 안에 오지 않으면 연결 종료
+	conn.SetReadDeadline(time.Now().Add(2 * interval))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(2 * interval))
+	})
+
+	// 클라이언트 메시지 수신 루프 (close, pong 처리용)
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	sub := resourcecollecter.Subscribe(config.Conf.Stream.BufferSize)
+	defer resourcecollecter.Unsubscribe(sub)
+
+	heartbeat := time.NewTicker(interval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-closed:
+			return
+		case <-streamCtx.Done():
+			conn.WriteControl(websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.CloseGoingAway, "server shutdown"),
+				time.Now().Add(time.Second))
+			return
+		case res, ok := <-sub.C():
+			if !ok {
+				return
+			}
+			data, err := json.Marshal(newStreamEvent(res, topics, sub.Dropped()))
+			if err != nil {
+				requestLogger(c).LogError("failed to marshal stream event: %v", err)
+				return
+			}
+			// 느린 클라이언트로 인해 전송이 막히지 않도록 쓰기 데드라인 설정
+			conn.SetWriteDeadline(time.Now().Add(interval))
+			if err := conn.WriteMessage(websocket.TextMessage, data); err != nil {
+				return
+			}
+		case <-heartbeat.C:
+			err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(interval))
+			if err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,245 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package server
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/meloncoffee/unisys/config"
+	"golang.org/x/net/http2"
+)
+
+// tlsPreset TLS 정책 프리셋
+type tlsPreset struct {
+	minVersion   uint16
+	maxVersion   uint16
+	cipherSuites []uint16 // TLS 1.2 이하 암호 스위트 (TLS 1.3 스위트는 Go가 고정)
+	curves       []tls.CurveID
+}
+
+// tlsVersions 설정 이름별 TLS 버전
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// tlsCurves 설정 이름별 키 교환 곡선
+var tlsCurves = map[string]tls.CurveID{
+	"X25519": tls.X25519,
+	"P-256":  tls.CurveP256,
+	"P-384":  tls.CurveP384,
+	"P-521":  tls.CurveP521,
+}
+
+// intermediateCipherSuites 전방 보안 AEAD 암호 스위트
+var intermediateCipherSuites = []uint16{
+	tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+	tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+	tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+	tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+	tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256,
+	tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256,
+}
+
+// tlsPresets 프리셋 이름별 TLS 정책 (Mozilla Server Side TLS modern/intermediate/old 기준)
+var tlsPresets = map[string]tlsPreset{
+	// TLS 1.3 전용
+	"modern": {
+		minVersion: tls.VersionTLS13,
+		maxVersion: tls.VersionTLS13,
+		curves:     []tls.CurveID{tls.X25519, tls.CurveP256, tls.CurveP384},
+	},
+	// TLS 1.2 이상, 전방 보안 AEAD 스위트만 허용
+	"intermediate": {
+		minVersion:   tls.VersionTLS12,
+		maxVersion:   tls.VersionTLS13,
+		cipherSuites: intermediateCipherSuites,
+		curves:       []tls.CurveID{tls.X25519, tls.CurveP256, tls.CurveP384},
+	},
+	// TLS 1.0 이상, 구형 클라이언트용 CBC/RSA 키 교환 스위트 허용
+	"legacy": {
+		minVersion: tls.VersionTLS10,
+		maxVersion: tls.VersionTLS13,
+		cipherSuites: append(append([]uint16(nil), intermediateCipherSuites...),
+			tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256,
+			tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256,
+			tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA,
+			tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA,
+			tls.TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA,
+			tls.TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA,
+			tls.TLS_RSA_WITH_AES_128_GCM_SHA256,
+			tls.TLS_RSA_WITH_AES_256_GCM_SHA384,
+			tls.TLS_RSA_WITH_AES_128_CBC_SHA256,
+			tls.TLS_RSA_WITH_AES_128_CBC_SHA,
+			tls.TLS_RSA_WITH_AES_256_CBC_SHA,
+			tls.TLS_RSA_WITH_3DES_EDE_CBC_SHA,
+		),
+		curves: []tls.CurveID{tls.X25519, tls.CurveP256, tls.CurveP384},
+	},
+}
+
+// parseCipherSuites 암호 스위트 이름 목록 파싱
+//
+// Parameters:
+//   - names: IANA 암호 스위트 이름 목록
+//
+// Returns:
+//   - []uint16: 암호 스위트 ID 목록
+//   - error: 성공(nil), 실패(error)
+func parseCipherSuites(names []string) ([]uint16, error) {
+	known := make(map[string]*tls.CipherSuite)
+	for _, suite := range append(tls.CipherSuites(), tls.InsecureCipherSuites()...) {
+		known[suite.Name] = suite
+	}
+
+	ids := make([]uint16, 0, len(names))
+	for _, name := range names {
+		suite, ok := known[strings.ToUpper(strings.TrimSpace(name))]
+		if !ok {
+			return nil, fmt.Errorf("unknown cipher suite %q", name)
+		}
+		// TLS 1.3 스위트는 Go가 항상 모두 사용하며 설정할 수 없음
+		if len(suite.SupportedVersions) == 1 && suite.SupportedVersions[0] == tls.VersionTLS13 {
+			return nil, fmt.Errorf("cipher suite %q is TLS 1.3 only and cannot be configured", name)
+		}
+		ids = append(ids, suite.ID)
+	}
+	return ids, nil
+}
+
+// parseCurves 키 교환 곡선 이름 목록 파싱
+//
+// Parameters:
+//   - names: 곡선 이름 목록
+//
+// Returns:
+//   - []tls.CurveID: 곡선 ID 목록
+//   - error: 성공(nil), 실패(error)
+func parseCurves(names []string) ([]tls.CurveID, error) {
+	curves := make([]tls.CurveID, 0, len(names))
+	for _, name := range names {
+		curve, ok := tlsCurves[strings.ToUpper(strings.TrimSpace(name))]
+		if !ok {
+			return nil, fmt.Errorf("unknown curve %q (X25519, P-256, P-384, P-521)", name)
+		}
+		curves = append(curves, curve)
+	}
+	return curves, nil
+}
+
+// parseTLSVersion TLS 버전 이름 파싱
+//
+// Parameters:
+//   - name: 버전 이름 (1.0, 1.1, 1.2, 1.3)
+//   - def: 이름이 비어 있을 때 사용할 버전
+//
+// Returns:
+//   - uint16: TLS 버전
+//   - error: 성공(nil), 실패(error)
+func parseTLSVersion(name string, def uint16) (uint16, error) {
+	name = strings.TrimPrefix(strings.TrimSpace(name), "TLS")
+	if name == "" {
+		return def, nil
+	}
+	version, ok := tlsVersions[name]
+	if !ok {
+		return 0, fmt.Errorf("unknown TLS version %q (1.0, 1.1, 1.2, 1.3)", name)
+	}
+	return version, nil
+}
+
+// newTLSConfig 설정 파일의 TLS 정책으로 TLS 설정 생성 (인증서 설정 제외)
+//
+// Returns:
+//   - *tls.Config: TLS 설정
+//   - error: 성공(nil), 실패(error)
+func newTLSConfig() (*tls.Config, error) {
+	policy := config.Conf.Server.TLS
+	preset, ok := tlsPresets[policy.Preset]
+	if !ok {
+		return nil, fmt.Errorf("unknown TLS preset %q (modern, intermediate, legacy)", policy.Preset)
+	}
+
+	tlsConf := &tls.Config{
+		MinVersion:       preset.minVersion,
+		MaxVersion:       preset.maxVersion,
+		CipherSuites:     preset.cipherSuites,
+		CurvePreferences: preset.curves,
+	}
+
+	var err error
+	if tlsConf.MinVersion, err = parseTLSVersion(policy.MinVersion, preset.minVersion); err != nil {
+		return nil, fmt.Errorf("minVersion: %v", err)
+	}
+	if tlsConf.MaxVersion, err = parseTLSVersion(policy.MaxVersion, preset.maxVersion); err != nil {
+		return nil, fmt.Errorf("maxVersion: %v", err)
+	}
+	if tlsConf.MinVersion > tlsConf.MaxVersion {
+		return nil, fmt.Errorf("minVersion %s is higher than maxVersion %s",
+			tls.VersionName(tlsConf.MinVersion), tls.VersionName(tlsConf.MaxVersion))
+	}
+	if len(policy.CipherSuites) > 0 {
+		if tlsConf.MinVersion == tls.VersionTLS13 {
+			return nil, fmt.Errorf("cipherSuites only apply to TLS 1.2 and earlier")
+		}
+		if tlsConf.CipherSuites, err = parseCipherSuites(policy.CipherSuites); err != nil {
+			return nil, fmt.Errorf("cipherSuites: %v", err)
+		}
+	}
+	if len(policy.Curves) > 0 {
+		if tlsConf.CurvePreferences, err = parseCurves(policy.Curves); err != nil {
+			return nil, fmt.Errorf("curves: %v", err)
+		}
+	}
+
+	// 애플리케이션 계층 프로토콜(HTTP/2, HTTP/1.1) 설정 (서버 우선순위 순서)
+	if config.Conf.Server.HTTP2.Enabled {
+		if tlsConf.MaxVersion < tls.VersionTLS12 {
+			return nil, fmt.Errorf("http2 requires TLS 1.2 or later")
+		}
+		tlsConf.NextProtos = []string{http2.NextProtoTLS, "http/1.1"}
+	} else {
+		tlsConf.NextProtos = []string{"http/1.1"}
+	}
+
+	return tlsConf, nil
+}
+
+// configureHTTP2 TLS 서버의 HTTP/2 사용 여부 및 설정 적용
+//
+// Parameters:
+//   - server: TLS 설정이 적용된 HTTP 서버
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func configureHTTP2(server *http.Server) error {
+	if !config.Conf.Server.HTTP2.Enabled {
+		// 비어 있는 map을 설정하면 net/http가 HTTP/2를 자동으로 활성화하지 않음
+		server.TLSNextProto = make(map[string]func(*http.Server, *tls.Conn, http.Handler))
+		return nil
+	}
+
+	// HTTP/2 필수 암호 스위트 포함 여부도 함께 검사됨
+	return http2.ConfigureServer(server, &http2.Server{
+		MaxConcurrentStreams: uint32(config.Conf.Server.HTTP2.MaxConcurrentStreams),
+	})
+}
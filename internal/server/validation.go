@@ -0,0 +1,140 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/meloncoffee/unisys/internal/logger"
+)
+
+// registerValidations 공용 검증 규칙 등록 (gin 엔진 생성 시 한번만 호출)
+func registerValidations() {
+	v, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		logger.Log.LogWarn("unexpected binding validator engine: %T", binding.Validator.Engine())
+		return
+	}
+
+	// 에러 메시지에 Go 필드명 대신 form/json 태그명을 사용
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		for _, tag := range []string{"form", "json"} {
+			name, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+			if name != "" && name != "-" {
+				return name
+			}
+		}
+		return field.Name
+	})
+
+	// 스트리밍 토픽 목록 검증
+	v.RegisterValidation("topics", func(fl validator.FieldLevel) bool {
+		_, err := parseStreamTopics(fl.Field().String())
+		return err == nil
+	})
+}
+
+// bindQuery 쿼리 파라미터를 구조체에 바인딩하고 검증
+// 실패 시 problem+json(400) 응답을 전송하고 false 반환
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+//   - obj: 바인딩 대상 구조체 포인터
+//
+// Returns:
+//   - bool: 성공(true), 실패(false)
+func bindQuery(c *gin.Context, obj interface{}) bool {
+	return bindWith(c, obj, c.ShouldBindQuery(obj))
+}
+
+// bindJSON 요청 바디(JSON)를 구조체에 바인딩하고 검증
+// 실패 시 problem+json(400) 응답을 전송하고 false 반환
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+//   - obj: 바인딩 대상 구조체 포인터
+//
+// Returns:
+//   - bool: 성공(true), 실패(false)
+func bindJSON(c *gin.Context, obj interface{}) bool {
+	return bindWith(c, obj, c.ShouldBindJSON(obj))
+}
+
+// bindWith 바인딩 결과를 problem+json 응답으로 변환
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+//   - obj: 바인딩 대상 구조체 포인터
+//   - err: 바인딩 결과
+//
+// Returns:
+//   - bool: 성공(true), 실패(false)
+func bindWith(c *gin.Context, obj interface{}, err error) bool {
+	if err == nil {
+		return true
+	}
+
+	var verrs validator.ValidationErrors
+	if !errors.As(err, &verrs) {
+		// 타입 변환 실패, 잘못된 JSON 등
+		abortWithError(c, http.StatusBadRequest, ErrCodeBadRequest, err.Error())
+		return false
+	}
+
+	problem := newProblem(c, http.StatusBadRequest, ErrCodeValidation, "request validation failed")
+	for _, fe := range verrs {
+		problem.Errors = append(problem.Errors, FieldError{
+			Field:   fe.Field(),
+			Rule:    fe.Tag(),
+			Message: validationMessage(fe),
+		})
+	}
+	abortWithProblem(c, problem)
+
+	return false
+}
+
+// validationMessage 검증 실패 정보를 사람이 읽을 수 있는 메시지로 변환
+//
+// Parameters:
+//   - fe: 필드 검증 실패 정보
+//
+// Returns:
+//   - string: 메시지
+func validationMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return fmt.Sprintf("%s is required", fe.Field())
+	case "oneof":
+		return fmt.Sprintf("%s must be one of [%s]", fe.Field(), fe.Param())
+	case "min", "gte":
+		return fmt.Sprintf("%s must be at least %s", fe.Field(), fe.Param())
+	case "max", "lte":
+		return fmt.Sprintf("%s must be at most %s", fe.Field(), fe.Param())
+	case "topics":
+		return fmt.Sprintf("%s must be a comma separated list of cpu, memory, disks, network", fe.Field())
+	default:
+		return fmt.Sprintf("%s failed on the '%s' rule", fe.Field(), fe.Tag())
+	}
+}
@@ -49,9 +49,19 @@ type MemStat struct {
 
 // DiskStat 디스크 상태 정보 구조체
 type DiskStat struct {
-	Total uint64 // 총 디스크 크기 (byte)
-	Free  uint64 // 사용 가능한 공간 (byte)
-	Used  uint64 // 사용된 공간 (byte)
+	MountPoint string // 마운트 경로
+	Total      uint64 // 총 디스크 크기 (byte)
+	Free       uint64 // 사용 가능한 공간 (byte)
+	Used       uint64 // 사용된 공간 (byte)
+}
+
+// pseudoFileSystems 디스크 사용률 수집에서 제외할 가상 파일 시스템 목록
+var pseudoFileSystems = map[string]struct{}{
+	"autofs": {}, "binfmt_misc": {}, "bpf": {}, "cgroup": {}, "cgroup2": {},
+	"configfs": {}, "debugfs": {}, "devpts": {}, "devtmpfs": {}, "fusectl": {},
+	"hugetlbfs": {}, "mqueue": {}, "nsfs": {}, "proc": {}, "pstore": {},
+	"ramfs": {}, "rpc_pipefs": {}, "securityfs": {}, "squashfs": {}, "sysfs": {},
+	"tmpfs": {}, "tracefs": {},
 }
 
 // NetworkTraffic 네트워크 트래픽 상태 정보 구조체
@@ -217,12 +227,87 @@ func GetDiskStat(path string) (DiskStat, error) {
 
 	// 디스크 상태 정보 반환
 	return DiskStat{
-		Total: total,
-		Free:  free,
-		Used:  used,
+		MountPoint: path,
+		Total:      total,
+		Free:       free,
+		Used:       used,
 	}, nil
 }
 
+// GetMountPoints 디스크 사용률 수집 대상 마운트 경로 목록 획득
+// 가상 파일 시스템은 제외하며, 동일 장치가 여러 경로에 마운트된 경우 첫 경로만 사용
+//
+// Returns:
+//   - []string: 마운트 경로 목록 (루트 경로가 항상 첫 번째)
+//   - error: 성공(nil), 실패(error)
+func GetMountPoints() ([]string, error) {
+	// 마운트 정보 파일 읽기
+	data, err := os.ReadFile("/proc/mounts")
+	if err != nil {
+		return nil, err
+	}
+
+	mountPoints := []string{"/"}
+	devices := make(map[string]struct{})
+
+	lines := strings.Split(string(data), "\n")
+	for _, line := range lines {
+		// 장치명, 마운트 경로, 파일 시스템 타입 순으로 파싱
+		fields := strings.Fields(line)
+		if len(fields) < 3 {
+			continue
+		}
+
+		// 가상 파일 시스템 제외
+		if _, ok := pseudoFileSystems[fields[2]]; ok {
+			continue
+		}
+
+		mountPoint := unescapeMountPath(fields[1])
+		if mountPoint == "/" {
+			devices[fields[0]] = struct{}{}
+			continue
+		}
+
+		// 동일 장치 중복 제외
+		if _, ok := devices[fields[0]]; ok {
+			continue
+		}
+		devices[fields[0]] = struct{}{}
+
+		mountPoints = append(mountPoints, mountPoint)
+	}
+
+	return mountPoints, nil
+}
+
+// unescapeMountPath /proc/mounts의 8진수 이스케이프 문자(\040 등) 복원
+//
+// Parameters:
+//   - path: 이스케이프된 마운트 경로
+//
+// Returns:
+//   - string: 복원된 마운트 경로
+func unescapeMountPath(path string) string {
+	if !strings.Contains(path, "\\") {
+		return path
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(path); i++ {
+		if path[i] == '\\' && i+3 < len(path) {
+			if c, err := strconv.ParseUint(path[i+1:i+4], 8, 8); err == nil {
+				sb.WriteByte(byte(c))
+				i += 3
+				continue
+			}
+		}
+		sb.WriteByte(path[i])
+	}
+
+	return sb.String()
+}
+
 // CalculateDiskRate 디스크 사용률 계산
 //
 // Parameters: